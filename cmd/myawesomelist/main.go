@@ -9,6 +9,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"myawesomelist.shikanime.studio/internal/awesome"
+	"myawesomelist.shikanime.studio/internal/awesome/github"
 	"myawesomelist.shikanime.studio/internal/awesome/http"
 	"myawesomelist.shikanime.studio/internal/config"
 	"myawesomelist.shikanime.studio/internal/database"
@@ -35,8 +36,11 @@ func main() {
 }
 
 var (
-	addr string
-	dsn  string
+	addr     string
+	dsn      string
+	cloneDir string
+	embModel string
+	embBack  string
 )
 
 // RunServerWithConf runs the HTTP server with the given configuration.
@@ -87,6 +91,36 @@ func RunMigrateUpWithConf(cfg *config.Config) error {
 	return mg.Up()
 }
 
+// RunIndexRebuildWithConf rebuilds the embedding indexes with the given configuration.
+func RunIndexRebuildWithConf(cfg *config.Config) error {
+	if dsn != "" {
+		cfg.Set("dsn", dsn)
+	}
+	method, err := database.ParseVectorIndex(cfg.GetVectorIndex())
+	if err != nil {
+		return err
+	}
+	db, err := database.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+	ctx := context.Background()
+	indexes, err := db.RebuildEmbeddingIndexes(ctx, database.RebuildEmbeddingIndexesArgs{
+		Method:         method,
+		M:              cfg.GetHNSWM(),
+		EfConstruction: cfg.GetHNSWEfConstruction(),
+		Lists:          cfg.GetIVFFlatLists(),
+	})
+	if err != nil {
+		return err
+	}
+	for _, idx := range indexes {
+		slog.InfoContext(ctx, "embedding index rebuilt", "name", idx.Name, "model", idx.Model, "method", idx.Method, "options", idx.Options)
+	}
+	return nil
+}
+
 // RunMigrateDownWithConf reverts all applied migrations with the given configuration.
 func RunMigrateDownWithConf(cfg *config.Config) error {
 	if dsn != "" {
@@ -99,7 +133,116 @@ func RunMigrateDownWithConf(cfg *config.Config) error {
 	return mg.Down()
 }
 
+// RunEmbedAllProjectsWithConf embeds the staled projects with the configured model, first
+// adopting the embeddings stored before models were recorded so they keep serving search.
 func RunEmbedAllProjectsWithConf(cfg *config.Config) error {
+	return runEmbedAllProjectsWithConf(cfg, true)
+}
+
+func runEmbedAllProjectsWithConf(cfg *config.Config, adopt bool) error {
+	if dsn != "" {
+		cfg.Set("dsn", dsn)
+	}
+	aw, err := awesome.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+	defer aw.Close()
+	ctx := context.Background()
+	if adopt {
+		if err := aw.Agent().AdoptLegacyProjectEmbeddings(ctx); err != nil {
+			return err
+		}
+	}
+	return aw.Agent().UpsertAllStaledProjectEmbeddings(ctx, cfg.GetProjectEmbeddingsTTL())
+}
+
+// RunReembedAllProjectsWithConf embeds every project with the model named by embModel, and the
+// backend named by embBack when set, leaving the configured model serving search. Legacy
+// embeddings are not adopted, since the backfilled model did not produce them.
+func RunReembedAllProjectsWithConf(cfg *config.Config) error {
+	if embModel != "" {
+		cfg.Set("embedding_model", embModel)
+	}
+	if embBack != "" {
+		cfg.Set("embedding_backend", embBack)
+	}
+	return runEmbedAllProjectsWithConf(cfg, false)
+}
+
+// RunAdoptLegacyEmbeddingsWithConf records the configured model on embeddings stored before
+// models were recorded with the given configuration.
+func RunAdoptLegacyEmbeddingsWithConf(cfg *config.Config) error {
+	if dsn != "" {
+		cfg.Set("dsn", dsn)
+	}
+	aw, err := awesome.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+	defer aw.Close()
+	return aw.Agent().AdoptLegacyProjectEmbeddings(context.Background())
+}
+
+// RunPruneEmbeddingsWithConf deletes the embeddings of other models than the configured one with
+// the given configuration.
+func RunPruneEmbeddingsWithConf(cfg *config.Config) error {
+	if dsn != "" {
+		cfg.Set("dsn", dsn)
+	}
+	aw, err := awesome.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+	defer aw.Close()
+	return aw.Agent().PruneProjectEmbeddings(context.Background(), cfg.GetQueryEmbeddingCacheTTL())
+}
+
+// RunHealthAllProjectsWithConf recomputes staled project health scores with the given configuration.
+func RunHealthAllProjectsWithConf(cfg *config.Config) error {
+	if dsn != "" {
+		cfg.Set("dsn", dsn)
+	}
+	aw, err := awesome.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+	defer aw.Close()
+	return aw.Health().
+		UpdateAllStaledHealthScores(context.Background(), cfg.GetProjectHealthTTL())
+}
+
+// RunReadmeAllProjectsWithConf fetches staled listed project READMEs with the given configuration.
+func RunReadmeAllProjectsWithConf(cfg *config.Config) error {
+	if dsn != "" {
+		cfg.Set("dsn", dsn)
+	}
+	aw, err := awesome.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+	defer aw.Close()
+	return aw.GitHub().
+		UpsertAllStaledProjectReadmes(context.Background(), cfg.GetProjectReadmeTTL())
+}
+
+// RunRegistryAllProjectsWithConf fetches staled package registry stats with the given configuration.
+func RunRegistryAllProjectsWithConf(cfg *config.Config) error {
+	if dsn != "" {
+		cfg.Set("dsn", dsn)
+	}
+	aw, err := awesome.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+	defer aw.Close()
+	return aw.Registry().
+		UpsertAllStaledRegistryStats(context.Background(), cfg.GetRegistryStatsTTL())
+}
+
+// RunHistoryAllCollectionsWithConf backfills when collection entries were added from the README history
+// with the given configuration, reading local clones when cloneDir is set.
+func RunHistoryAllCollectionsWithConf(cfg *config.Config) error {
 	if dsn != "" {
 		cfg.Set("dsn", dsn)
 	}
@@ -108,8 +251,26 @@ func RunEmbedAllProjectsWithConf(cfg *config.Config) error {
 		return err
 	}
 	defer aw.Close()
-	return aw.Agent().
-		UpsertAllStaledProjectEmbeddings(context.Background(), cfg.GetProjectEmbeddingsTTL())
+	var src github.CommitSource
+	if cloneDir != "" {
+		src = github.NewGitCloneSource(cloneDir)
+	}
+	return aw.GitHub().BackfillAllCollectionHistory(context.Background(), src)
+}
+
+// RunManifestsAllProjectsWithConf fetches staled dependency manifests and rebuilds the dependency graph
+// with the given configuration.
+func RunManifestsAllProjectsWithConf(cfg *config.Config) error {
+	if dsn != "" {
+		cfg.Set("dsn", dsn)
+	}
+	aw, err := awesome.NewForConfig(cfg)
+	if err != nil {
+		return err
+	}
+	defer aw.Close()
+	return aw.GitHub().
+		UpsertAllStaledProjectManifests(context.Background(), cfg.GetProjectManifestsTTL())
 }
 
 // NewServeCmdForConf returns a new cobra.Command for running the API server with the given configuration.
@@ -164,15 +325,150 @@ func NewJobsEmbStartCmdForConfig(cfg *config.Config) *cobra.Command {
 	return c
 }
 
+func NewJobsEmbReembedCmdForConfig(cfg *config.Config) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "reembed",
+		Short: "Embed all projects with another model while search keeps using the configured one",
+		RunE:  func(_ *cobra.Command, _ []string) error { return RunReembedAllProjectsWithConf(cfg) },
+	}
+	c.Flags().
+		StringVar(&embModel, "model", "", "Embedding model to backfill. If empty, uses the EMBEDDING_MODEL environment variable")
+	c.Flags().
+		StringVar(&embBack, "backend", "", "Embeddings backend of the model. If empty, uses the EMBEDDING_BACKEND environment variable")
+	return c
+}
+
+func NewJobsEmbAdoptCmdForConfig(cfg *config.Config) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "adopt",
+		Short: "Record the configured model on embeddings stored before models were recorded",
+		RunE:  func(_ *cobra.Command, _ []string) error { return RunAdoptLegacyEmbeddingsWithConf(cfg) },
+	}
+	return c
+}
+
+func NewJobsEmbPruneCmdForConfig(cfg *config.Config) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "prune",
+		Short: "Delete the embeddings of other models than the configured one and unused query embeddings",
+		RunE:  func(_ *cobra.Command, _ []string) error { return RunPruneEmbeddingsWithConf(cfg) },
+	}
+	return c
+}
+
 func NewJobsEmbCmdForConfig(cfg *config.Config) *cobra.Command {
 	c := &cobra.Command{Use: "embeding", Short: "Embeddings jobs"}
-	c.AddCommand(NewJobsEmbStartCmdForConfig(cfg))
+	c.AddCommand(
+		NewJobsEmbStartCmdForConfig(cfg),
+		NewJobsEmbReembedCmdForConfig(cfg),
+		NewJobsEmbAdoptCmdForConfig(cfg),
+		NewJobsEmbPruneCmdForConfig(cfg),
+	)
+	return c
+}
+
+func NewJobsHealthStartCmdForConfig(cfg *config.Config) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "start",
+		Short: "Compute staled project health scores",
+		RunE:  func(_ *cobra.Command, _ []string) error { return RunHealthAllProjectsWithConf(cfg) },
+	}
+	return c
+}
+
+func NewJobsHealthCmdForConfig(cfg *config.Config) *cobra.Command {
+	c := &cobra.Command{Use: "health", Short: "Health score jobs"}
+	c.AddCommand(NewJobsHealthStartCmdForConfig(cfg))
+	return c
+}
+
+func NewJobsHistoryStartCmdForConfig(cfg *config.Config) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "start",
+		Short: "Backfill when collection entries were added from the README history",
+		RunE:  func(_ *cobra.Command, _ []string) error { return RunHistoryAllCollectionsWithConf(cfg) },
+	}
+	c.Flags().
+		StringVar(&cloneDir, "clone-dir", "", "Directory holding local clones as <owner>/<repo>. If empty, uses the GitHub commits API")
+	return c
+}
+
+func NewJobsHistoryCmdForConfig(cfg *config.Config) *cobra.Command {
+	c := &cobra.Command{Use: "history", Short: "Collection history jobs"}
+	c.AddCommand(NewJobsHistoryStartCmdForConfig(cfg))
+	return c
+}
+
+func NewJobsManifestsStartCmdForConfig(cfg *config.Config) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "start",
+		Short: "Fetch staled dependency manifests and rebuild the dependency graph",
+		RunE:  func(_ *cobra.Command, _ []string) error { return RunManifestsAllProjectsWithConf(cfg) },
+	}
+	return c
+}
+
+func NewJobsManifestsCmdForConfig(cfg *config.Config) *cobra.Command {
+	c := &cobra.Command{Use: "manifests", Short: "Dependency manifest jobs"}
+	c.AddCommand(NewJobsManifestsStartCmdForConfig(cfg))
+	return c
+}
+
+func NewJobsReadmeStartCmdForConfig(cfg *config.Config) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "start",
+		Short: "Fetch staled project READMEs",
+		RunE:  func(_ *cobra.Command, _ []string) error { return RunReadmeAllProjectsWithConf(cfg) },
+	}
+	return c
+}
+
+func NewJobsReadmeCmdForConfig(cfg *config.Config) *cobra.Command {
+	c := &cobra.Command{Use: "readme", Short: "README ingestion jobs"}
+	c.AddCommand(NewJobsReadmeStartCmdForConfig(cfg))
+	return c
+}
+
+func NewJobsRegistryStartCmdForConfig(cfg *config.Config) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "start",
+		Short: "Fetch staled package registry stats",
+		RunE:  func(_ *cobra.Command, _ []string) error { return RunRegistryAllProjectsWithConf(cfg) },
+	}
+	return c
+}
+
+func NewJobsRegistryCmdForConfig(cfg *config.Config) *cobra.Command {
+	c := &cobra.Command{Use: "registry", Short: "Package registry stats jobs"}
+	c.AddCommand(NewJobsRegistryStartCmdForConfig(cfg))
+	return c
+}
+
+func NewIndexRebuildCmdForConfig(cfg *config.Config) *cobra.Command {
+	c := &cobra.Command{
+		Use:   "rebuild",
+		Short: "Rebuild the embedding indexes of every model",
+		RunE:  func(_ *cobra.Command, _ []string) error { return RunIndexRebuildWithConf(cfg) },
+	}
+	return c
+}
+
+func NewIndexCmdForConfig(cfg *config.Config) *cobra.Command {
+	c := &cobra.Command{Use: "index", Short: "Embedding indexes"}
+	c.AddCommand(NewIndexRebuildCmdForConfig(cfg))
 	return c
 }
 
 func NewJobsCmdForConfig(cfg *config.Config) *cobra.Command {
 	c := &cobra.Command{Use: "jobs", Short: "Background jobs"}
-	c.AddCommand(NewJobsEmbCmdForConfig(cfg))
+	c.AddCommand(
+		NewJobsEmbCmdForConfig(cfg),
+		NewJobsHealthCmdForConfig(cfg),
+		NewJobsHistoryCmdForConfig(cfg),
+		NewJobsManifestsCmdForConfig(cfg),
+		NewJobsReadmeCmdForConfig(cfg),
+		NewJobsRegistryCmdForConfig(cfg),
+	)
 	return c
 }
 
@@ -181,6 +477,11 @@ func NewCmdForConfig(cfg *config.Config) *cobra.Command {
 	c := &cobra.Command{Use: "myawesomelist", Short: "Awesome list server and utilities"}
 	c.PersistentFlags().
 		StringVar(&dsn, "dsn", "", "Database source name in the format driver://dataSourceName. Falls back to DSN environment variable")
-	c.AddCommand(NewServerCmdForConfig(cfg), NewMigrateCmdForConfig(cfg), NewJobsCmdForConfig(cfg))
+	c.AddCommand(
+		NewServerCmdForConfig(cfg),
+		NewMigrateCmdForConfig(cfg),
+		NewIndexCmdForConfig(cfg),
+		NewJobsCmdForConfig(cfg),
+	)
 	return c
 }
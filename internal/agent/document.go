@@ -0,0 +1,166 @@
+package agent
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// DefaultReadmeExcerptLen is the default length up to which README paragraphs are embedded.
+const DefaultReadmeExcerptLen = 1000
+
+// DefaultDocumentTemplate renders the name and description of a project, the language of its
+// collection, its category path, its topics and the first paragraphs of its README.
+const DefaultDocumentTemplate = `{{.Name}}{{with .Description}}: {{.}}{{end}}
+{{with .Language}}Language: {{.}}
+{{end}}{{with .CategoryPath}}Category: {{join . " > "}}
+{{end}}{{with .Topics}}Topics: {{join . ", "}}
+{{end}}{{with .Readme}}
+{{.}}{{end}}`
+
+// ProjectDocument holds the fields of a project embedded for semantic search.
+type ProjectDocument struct {
+	Name        string
+	Description string
+	// Collection names the awesome list listing the project.
+	Collection string
+	Category   string
+	// Language is the language of the collection.
+	Language string
+	Topics   []string
+	Readme   string
+}
+
+// documentData is the data a DocumentTemplate is executed with.
+type documentData struct {
+	ProjectDocument
+	// CategoryPath is the collection followed by the category.
+	CategoryPath []string
+}
+
+// DocumentTemplate renders the text embedded for a project.
+type DocumentTemplate struct {
+	t         *template.Template
+	readmeLen int
+}
+
+// NewDocumentTemplate parses text as a text/template executed with the fields of
+// ProjectDocument, a CategoryPath slice, and the join, lower and upper functions. The Readme
+// field holds the first paragraphs of the README, up to about readmeLen bytes.
+func NewDocumentTemplate(text string, readmeLen int) (*DocumentTemplate, error) {
+	t, err := template.New("document").Funcs(template.FuncMap{
+		"join":  strings.Join,
+		"lower": strings.ToLower,
+		"upper": strings.ToUpper,
+	}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid embedding document template: %w", err)
+	}
+	if readmeLen <= 0 {
+		readmeLen = DefaultReadmeExcerptLen
+	}
+	dt := &DocumentTemplate{t: t, readmeLen: readmeLen}
+	// Templates referring to unknown fields only fail when executed.
+	if _, err := dt.Render(ProjectDocument{
+		Name:       "name",
+		Collection: "collection",
+		Category:   "category",
+		Topics:     []string{"topic"},
+		Readme:     "readme",
+	}); err != nil {
+		return nil, err
+	}
+	return dt, nil
+}
+
+var defaultDocumentTemplate = func() *DocumentTemplate {
+	t, err := NewDocumentTemplate(DefaultDocumentTemplate, DefaultReadmeExcerptLen)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}()
+
+// Render returns the text embedded for d, trimmed of surrounding whitespace.
+func (t *DocumentTemplate) Render(d ProjectDocument) (string, error) {
+	data := documentData{ProjectDocument: d}
+	data.Readme = ReadmeExcerpt(d.Readme, t.readmeLen)
+	for _, s := range []string{d.Collection, d.Category} {
+		if s != "" {
+			data.CategoryPath = append(data.CategoryPath, s)
+		}
+	}
+	var buf bytes.Buffer
+	if err := t.t.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("render embedding document failed: %w", err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// ReadmeExcerpt returns the first prose paragraphs of a Markdown README, up to about maxLen
+// bytes. Headings, badges, images, HTML, tables and code blocks are skipped.
+func ReadmeExcerpt(readme string, maxLen int) string {
+	var paragraphs []string
+	var cur []string
+	size := 0
+	flush := func() {
+		if len(cur) > 0 {
+			p := strings.Join(cur, " ")
+			paragraphs = append(paragraphs, p)
+			size += len(p)
+			cur = nil
+		}
+	}
+	inCode := false
+	for _, line := range strings.Split(readme, "\n") {
+		if size >= maxLen {
+			break
+		}
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "```") || strings.HasPrefix(line, "~~~") {
+			inCode = !inCode
+			flush()
+			continue
+		}
+		if inCode {
+			continue
+		}
+		switch {
+		case line == "":
+			flush()
+		case strings.HasPrefix(line, "#"),
+			strings.HasPrefix(line, "<"),
+			strings.HasPrefix(line, "!["),
+			strings.HasPrefix(line, "[!["),
+			strings.HasPrefix(line, "|"),
+			strings.HasPrefix(line, "---"),
+			strings.HasPrefix(line, "==="):
+			flush()
+		default:
+			cur = append(cur, line)
+		}
+	}
+	flush()
+	excerpt := strings.Join(paragraphs, "\n\n")
+	if len(excerpt) > maxLen {
+		excerpt = excerpt[:maxLen]
+		// Cut on a word boundary, which is also a rune boundary.
+		if i := strings.LastIndexAny(excerpt, " \n"); i > 0 {
+			excerpt = excerpt[:i]
+		}
+	}
+	return excerpt
+}
+
+// InputHash returns the hash of an embedding input, identifying the text and the model it is
+// embedded with, so that unchanged inputs are not embedded again.
+func InputHash(model, text string) string {
+	h := sha256.New()
+	h.Write([]byte(model))
+	h.Write([]byte{0})
+	h.Write([]byte(text))
+	return hex.EncodeToString(h.Sum(nil))
+}
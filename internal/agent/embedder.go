@@ -0,0 +1,43 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Embedder turns texts into vector embeddings.
+type Embedder interface {
+	// Model names the model producing the embeddings.
+	Model() string
+	// Embed returns one embedding per input, in the order of inputs.
+	Embed(ctx context.Context, inputs []string) ([][]float32, error)
+}
+
+// PostJSON posts body encoded as JSON to url with c and decodes the JSON response into v.
+func PostJSON(ctx context.Context, c *http.Client, url string, body, v any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.Do(req)
+	if err != nil {
+		return fmt.Errorf("request %s failed: %w", url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("request %s failed: %s", url, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", url, err)
+	}
+	return nil
+}
@@ -2,121 +2,362 @@ package agent
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
 
 	"log/slog"
 
-	sdk "github.com/openai/openai-go/v3"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/time/rate"
-	"myawesomelist.shikanime.studio/internal/agent/openai"
-	"myawesomelist.shikanime.studio/internal/config"
-	myawesomelistv1 "myawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 )
 
-// Embeddings generates vector embeddings for projects using an OpenAI client.
+const (
+	// DefaultBatchTokens is the default estimated token budget of a single embeddings request.
+	DefaultBatchTokens = 16384
+	// DefaultBatchSize is the default maximum number of inputs of a single embeddings request.
+	DefaultBatchSize = 128
+	// DefaultMaxInputTokens is the default estimated token limit of a single input; longer inputs
+	// are split into chunks whose embeddings are averaged.
+	DefaultMaxInputTokens = 8000
+	// DefaultConcurrency is the default number of embeddings requests in flight.
+	DefaultConcurrency = 4
+)
+
+// Embeddings generates vector embeddings for projects using an Embedder, packing inputs into
+// requests sized by an estimated token budget.
 type Embeddings struct {
-	c     *sdk.Client
-	model string
-	l     *rate.Limiter
+	e              Embedder
+	l              *rate.Limiter
+	batchTokens    int
+	batchSize      int
+	maxInputTokens int
+	concurrency    int
+	doc            *DocumentTemplate
 }
 
-type EmbeddingsOptions struct{ limiter *rate.Limiter }
+type EmbeddingsOptions struct {
+	limiter        *rate.Limiter
+	batchTokens    int
+	batchSize      int
+	maxInputTokens int
+	concurrency    int
+	doc            *DocumentTemplate
+}
 type EmbeddingsOption func(*EmbeddingsOptions)
 
 func WithLimiter(l *rate.Limiter) EmbeddingsOption {
 	return func(o *EmbeddingsOptions) { o.limiter = l }
 }
 
-// NewEmbeddingsForConfig constructs Embeddings by using the provided OpenAI client.
-func NewEmbeddingsForConfig(cfg *config.Config, opts ...EmbeddingsOption) *Embeddings {
-	return NewEmbeddingsWithOpenAI(cfg, openai.NewClientForConfig(cfg), opts...)
+// WithBatchTokens sets the estimated token budget of a single request.
+func WithBatchTokens(n int) EmbeddingsOption {
+	return func(o *EmbeddingsOptions) {
+		if n > 0 {
+			o.batchTokens = n
+		}
+	}
+}
+
+// WithBatchSize sets the maximum number of inputs of a single request.
+func WithBatchSize(n int) EmbeddingsOption {
+	return func(o *EmbeddingsOptions) {
+		if n > 0 {
+			o.batchSize = n
+		}
+	}
+}
+
+// WithMaxInputTokens sets the estimated token limit of a single input.
+func WithMaxInputTokens(n int) EmbeddingsOption {
+	return func(o *EmbeddingsOptions) {
+		if n > 0 {
+			o.maxInputTokens = n
+		}
+	}
 }
 
-// NewEmbeddingsWithOpenAI constructs Embeddings by using the provided OpenAI client.
-func NewEmbeddingsWithOpenAI(
-	cfg *config.Config,
-	c *sdk.Client,
-	opts ...EmbeddingsOption,
-) *Embeddings {
-	var o EmbeddingsOptions
+// WithDocumentTemplate sets the template rendering the text embedded for a project.
+func WithDocumentTemplate(t *DocumentTemplate) EmbeddingsOption {
+	return func(o *EmbeddingsOptions) {
+		if t != nil {
+			o.doc = t
+		}
+	}
+}
+
+// WithConcurrency sets the number of requests in flight.
+func WithConcurrency(n int) EmbeddingsOption {
+	return func(o *EmbeddingsOptions) {
+		if n > 0 {
+			o.concurrency = n
+		}
+	}
+}
+
+// NewEmbeddings constructs Embeddings by using the provided Embedder.
+func NewEmbeddings(e Embedder, opts ...EmbeddingsOption) *Embeddings {
+	o := EmbeddingsOptions{
+		batchTokens:    DefaultBatchTokens,
+		batchSize:      DefaultBatchSize,
+		maxInputTokens: DefaultMaxInputTokens,
+		concurrency:    DefaultConcurrency,
+		doc:            defaultDocumentTemplate,
+	}
 	for _, opt := range opts {
 		opt(&o)
 	}
-	e := &Embeddings{c: c, model: cfg.GetEmbeddingModel(), l: o.limiter}
-	slog.Debug("embeddings configured", "model", e.model, "limiter", e.l != nil)
-	return e
+	emb := &Embeddings{
+		e:              e,
+		l:              o.limiter,
+		batchTokens:    o.batchTokens,
+		batchSize:      o.batchSize,
+		maxInputTokens: min(o.maxInputTokens, o.batchTokens),
+		concurrency:    o.concurrency,
+		doc:            o.doc,
+	}
+	slog.Debug(
+		"embeddings configured",
+		"model", e.Model(),
+		"limiter", emb.l != nil,
+		"batch_tokens", emb.batchTokens,
+		"batch_size", emb.batchSize,
+		"max_input_tokens", emb.maxInputTokens,
+	)
+	return emb
 }
 
-// EmbedProjects returns embeddings for a slice of projects.
-func (e *Embeddings) EmbedProjects(
-	ctx context.Context,
-	inputs []*myawesomelistv1.Project,
-) ([][]float32, error) {
+// Model names the model producing the embeddings.
+func (e *Embeddings) Model() string { return e.e.Model() }
+
+// Document renders the text embedded for a project.
+func (e *Embeddings) Document(d ProjectDocument) (string, error) { return e.doc.Render(d) }
+
+// ItemError reports the failure to embed a single input.
+type ItemError struct {
+	Index int
+	Err   error
+}
+
+func (e *ItemError) Error() string { return fmt.Sprintf("input %d: %v", e.Index, e.Err) }
+
+func (e *ItemError) Unwrap() error { return e.Err }
+
+// EstimateTokens estimates the number of tokens of text, erring on the high side for code-like
+// text, which tokenizes worse than prose.
+func EstimateTokens(text string) int { return (len(text) + 2) / 3 }
+
+// splitInput splits text on whitespace into chunks of at most maxTokens estimated tokens.
+// Words longer than a chunk are cut on a rune boundary.
+func splitInput(text string, maxTokens int) []string {
+	if EstimateTokens(text) <= maxTokens {
+		return []string{text}
+	}
+	maxLen := maxTokens * 3
+	var chunks []string
+	var b strings.Builder
+	for _, w := range strings.Fields(text) {
+		for len(w) > maxLen {
+			if b.Len() > 0 {
+				chunks = append(chunks, b.String())
+				b.Reset()
+			}
+			n := maxLen
+			for n > 0 && !utf8.RuneStart(w[n]) {
+				n--
+			}
+			if n == 0 {
+				_, n = utf8.DecodeRuneInString(w)
+			}
+			chunks = append(chunks, w[:n])
+			w = w[n:]
+		}
+		if b.Len() > 0 && b.Len()+1+len(w) > maxLen {
+			chunks = append(chunks, b.String())
+			b.Reset()
+		}
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		b.WriteString(w)
+	}
+	if b.Len() > 0 {
+		chunks = append(chunks, b.String())
+	}
+	return chunks
+}
+
+// embeddingChunk is a piece of an input sent to the embedder.
+type embeddingChunk struct {
+	item   int
+	text   string
+	tokens int
+	vec    []float32
+	err    error
+}
+
+// batchChunks packs chunks in order into batches of at most batchSize chunks and batchTokens
+// estimated tokens.
+func batchChunks(chunks []*embeddingChunk, batchTokens, batchSize int) [][]*embeddingChunk {
+	var batches [][]*embeddingChunk
+	var cur []*embeddingChunk
+	var tokens int
+	for _, c := range chunks {
+		if len(cur) > 0 && (len(cur) >= batchSize || tokens+c.tokens > batchTokens) {
+			batches = append(batches, cur)
+			cur, tokens = nil, 0
+		}
+		cur = append(cur, c)
+		tokens += c.tokens
+	}
+	if len(cur) > 0 {
+		batches = append(batches, cur)
+	}
+	return batches
+}
+
+// EmbedTexts returns one embedding per input. Inputs are packed into requests sized by an
+// estimated token budget, and inputs longer than the input token limit are split into chunks
+// whose embeddings are averaged. When a request fails, its inputs are retried one by one; the
+// embedding of an input which still fails is nil and an ItemError for it is joined into the
+// returned error. Other errors, such as a cancelled context, return no embeddings.
+func (e *Embeddings) EmbedTexts(ctx context.Context, inputs []string) ([][]float32, error) {
 	tracer := otel.Tracer("myawesomelist/agent")
-	ctx, span := tracer.Start(ctx, "Embeddings.EmbedProjects")
+	ctx, span := tracer.Start(ctx, "Embeddings.EmbedTexts")
 	defer span.End()
 
-	out := make([][]float32, len(inputs))
+	var chunks []*embeddingChunk
+	for i, in := range inputs {
+		for _, text := range splitInput(in, e.maxInputTokens) {
+			chunks = append(chunks, &embeddingChunk{item: i, text: text, tokens: EstimateTokens(text)})
+		}
+	}
+	batches := batchChunks(chunks, e.batchTokens, e.batchSize)
+	model := e.e.Model()
+	span.SetAttributes(
+		attribute.String("model", model),
+		attribute.Int("inputs", len(inputs)),
+		attribute.Int("chunks", len(chunks)),
+		attribute.Int("batches", len(batches)),
+	)
 	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(e.concurrency)
+	for i, batch := range batches {
+		g.Go(func() error { return e.embedBatch(gctx, i, batch) })
+	}
+	if err := g.Wait(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+
+	out := make([][]float32, len(inputs))
+	failed := make(map[int]error)
+	parts := make([][]*embeddingChunk, len(inputs))
+	for _, c := range chunks {
+		if c.err != nil {
+			failed[c.item] = c.err
+		}
+		parts[c.item] = append(parts[c.item], c)
+	}
+	var errs []error
 	for i := range inputs {
-		i := i
-		g.Go(func() error {
-			igctx, cspan := tracer.Start(gctx, "Embeddings.EmbedProject")
-			cspan.SetAttributes(
-				attribute.Int("index", i),
-				attribute.String("model", e.model),
-				attribute.Int("name_len", len(inputs[i].Name)),
-				attribute.Int("desc_len", len(inputs[i].Description)),
-			)
-			if e.l != nil {
-				if err := e.l.Wait(igctx); err != nil {
-					cspan.RecordError(err)
-					cspan.SetStatus(codes.Error, err.Error())
-					cspan.End()
-					return err
-				}
-			}
-			slog.DebugContext(
-				igctx,
-				"embedding request",
-				"index",
-				i,
-				"model",
-				e.model,
-				"name_len",
-				len(inputs[i].Name),
-				"desc_len",
-				len(inputs[i].Description),
-			)
-			res, err := e.c.Embeddings.New(igctx, sdk.EmbeddingNewParams{
-				Input: sdk.EmbeddingNewParamsInputUnion{
-					OfString: sdk.String(inputs[i].Name + " " + inputs[i].Description),
-				},
-				Model: sdk.EmbeddingModel(e.model),
-			})
-			if err != nil {
-				cspan.RecordError(err)
-				cspan.SetStatus(codes.Error, err.Error())
-				cspan.End()
-				return err
-			}
-			v := make([]float32, len(res.Data[0].Embedding))
-			for j := range v {
-				v[j] = float32(res.Data[0].Embedding[j])
+		if err, ok := failed[i]; ok {
+			errs = append(errs, &ItemError{Index: i, Err: err})
+			continue
+		}
+		out[i] = averageChunks(parts[i])
+	}
+	span.SetAttributes(attribute.Int("failed", len(errs)))
+	slog.DebugContext(ctx, "embedding done", "model", model, "inputs", len(inputs), "batches", len(batches), "failed", len(errs))
+	return out, errors.Join(errs...)
+}
+
+// embedBatch embeds a batch, falling back to one request per chunk when the batch fails.
+func (e *Embeddings) embedBatch(ctx context.Context, index int, batch []*embeddingChunk) error {
+	tracer := otel.Tracer("myawesomelist/agent")
+	ctx, span := tracer.Start(ctx, "Embeddings.embedBatch")
+	span.SetAttributes(attribute.Int("index", index), attribute.Int("chunks", len(batch)))
+	defer span.End()
+	texts := make([]string, len(batch))
+	for i, c := range batch {
+		texts[i] = c.text
+	}
+	vecs, err := e.embed(ctx, texts)
+	if err == nil {
+		for i, c := range batch {
+			c.vec = vecs[i]
+		}
+		return nil
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	span.RecordError(err)
+	if len(batch) == 1 {
+		batch[0].err = err
+		return nil
+	}
+	slog.WarnContext(ctx, "embedding batch failed; retry inputs one by one", "index", index, "chunks", len(batch), "error", err)
+	for _, c := range batch {
+		vecs, err := e.embed(ctx, []string{c.text})
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
 			}
-			out[i] = v
-			cspan.SetAttributes(attribute.Int("dim", len(v)))
-			slog.DebugContext(igctx, "embedding response", "index", i, "dim", len(v))
-			cspan.End()
-			return nil
-		})
+			c.err = err
+			continue
+		}
+		c.vec = vecs[0]
 	}
-	if err := g.Wait(); err != nil {
+	return nil
+}
+
+func (e *Embeddings) embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if e.l != nil {
+		if err := e.l.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	vecs, err := e.e.Embed(ctx, texts)
+	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	if len(vecs) != len(texts) {
+		return nil, fmt.Errorf("expected %d embeddings, got %d", len(texts), len(vecs))
+	}
+	return vecs, nil
+}
+
+// averageChunks averages the embeddings of the chunks of an input weighted by their estimated
+// tokens, normalized back to unit length.
+func averageChunks(chunks []*embeddingChunk) []float32 {
+	if len(chunks) == 1 {
+		return chunks[0].vec
+	}
+	avg := make([]float32, len(chunks[0].vec))
+	for _, c := range chunks {
+		for j := range avg {
+			if j < len(c.vec) {
+				avg[j] += float32(c.tokens) * c.vec[j]
+			}
+		}
+	}
+	var norm float64
+	for _, x := range avg {
+		norm += float64(x) * float64(x)
+	}
+	if norm > 0 {
+		scale := float32(1 / math.Sqrt(norm))
+		for j := range avg {
+			avg[j] *= scale
+		}
+	}
+	return avg
 }
@@ -0,0 +1,114 @@
+package agent
+
+import (
+	"math"
+	"slices"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSplitInput(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		maxTokens int
+		want      []string
+	}{
+		{name: "short input", text: "hello world", maxTokens: 10, want: []string{"hello world"}},
+		{name: "split on whitespace", text: "aaa bbb\nccc", maxTokens: 2, want: []string{"aaa", "bbb", "ccc"}},
+		{name: "pack words", text: "a b c d e f g h", maxTokens: 2, want: []string{"a b c", "d e f", "g h"}},
+		{name: "cut long word", text: "abcdefg", maxTokens: 1, want: []string{"abc", "def", "g"}},
+		{name: "cut on rune boundary", text: "éééé", maxTokens: 1, want: []string{"é", "é", "é", "é"}},
+		{name: "rune longer than a chunk", text: "😀😀", maxTokens: 1, want: []string{"😀", "😀"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := splitInput(tt.text, tt.maxTokens)
+			if !slices.Equal(got, tt.want) {
+				t.Fatalf("splitInput() = %q, want %q", got, tt.want)
+			}
+			for _, c := range got {
+				if !utf8.ValidString(c) {
+					t.Errorf("splitInput() chunk %q is not valid UTF-8", c)
+				}
+			}
+		})
+	}
+}
+
+func TestBatchChunks(t *testing.T) {
+	tests := []struct {
+		name        string
+		tokens      []int
+		batchTokens int
+		batchSize   int
+		want        [][]int
+	}{
+		{name: "no chunks", tokens: nil, batchTokens: 4, batchSize: 2, want: nil},
+		{name: "single batch", tokens: []int{1, 1, 1}, batchTokens: 4, batchSize: 4, want: [][]int{{1, 1, 1}}},
+		{name: "batch size", tokens: []int{1, 1, 1}, batchTokens: 10, batchSize: 2, want: [][]int{{1, 1}, {1}}},
+		{name: "token budget", tokens: []int{2, 2, 2, 5, 1}, batchTokens: 4, batchSize: 2, want: [][]int{{2, 2}, {2}, {5}, {1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunks := make([]*embeddingChunk, len(tt.tokens))
+			for i, n := range tt.tokens {
+				chunks[i] = &embeddingChunk{item: i, tokens: n}
+			}
+			var got [][]int
+			for _, batch := range batchChunks(chunks, tt.batchTokens, tt.batchSize) {
+				var tokens []int
+				for _, c := range batch {
+					tokens = append(tokens, c.tokens)
+				}
+				got = append(got, tokens)
+			}
+			if !slices.EqualFunc(got, tt.want, slices.Equal) {
+				t.Fatalf("batchChunks() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAverageChunks(t *testing.T) {
+	tests := []struct {
+		name   string
+		chunks []*embeddingChunk
+		want   []float32
+	}{
+		{
+			name:   "single chunk",
+			chunks: []*embeddingChunk{{tokens: 5, vec: []float32{0.6, 0.8}}},
+			want:   []float32{0.6, 0.8},
+		},
+		{
+			name: "equal weights",
+			chunks: []*embeddingChunk{
+				{tokens: 1, vec: []float32{1, 0}},
+				{tokens: 1, vec: []float32{0, 1}},
+			},
+			want: []float32{float32(1 / math.Sqrt2), float32(1 / math.Sqrt2)},
+		},
+		{
+			name: "weighted by tokens",
+			chunks: []*embeddingChunk{
+				{tokens: 3, vec: []float32{1, 0}},
+				{tokens: 1, vec: []float32{0, 1}},
+			},
+			want: []float32{float32(3 / math.Sqrt(10)), float32(1 / math.Sqrt(10))},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := averageChunks(tt.chunks)
+			if len(got) != len(tt.want) {
+				t.Fatalf("averageChunks() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if math.Abs(float64(got[i]-tt.want[i])) > 1e-6 {
+					t.Fatalf("averageChunks() = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
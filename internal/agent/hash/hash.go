@@ -0,0 +1,75 @@
+package hash
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"strings"
+	"unicode"
+
+	"myawesomelist.shikanime.studio/internal/agent"
+)
+
+// Embedder embeds texts by feature hashing their words and word bigrams into a fixed number of
+// dimensions. Embeddings are deterministic and computed locally, which suits tests and offline
+// development; texts sharing words are close, but synonyms are not.
+type Embedder struct {
+	dims int
+}
+
+var _ agent.Embedder = (*Embedder)(nil)
+
+// NewEmbedder constructs an Embedder producing embeddings of dims dimensions.
+func NewEmbedder(dims int) *Embedder {
+	return &Embedder{dims: dims}
+}
+
+func (e *Embedder) Model() string { return fmt.Sprintf("hash-%d", e.dims) }
+
+// Embed embeds inputs locally.
+func (e *Embedder) Embed(_ context.Context, inputs []string) ([][]float32, error) {
+	out := make([][]float32, len(inputs))
+	for i, in := range inputs {
+		out[i] = e.embed(in)
+	}
+	return out, nil
+}
+
+func (e *Embedder) embed(text string) []float32 {
+	v := make([]float32, e.dims)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for i, w := range words {
+		e.add(v, w)
+		if i > 0 {
+			e.add(v, words[i-1]+" "+w)
+		}
+	}
+	var norm float64
+	for _, x := range v {
+		norm += float64(x) * float64(x)
+	}
+	if norm == 0 {
+		return v
+	}
+	scale := float32(1 / math.Sqrt(norm))
+	for i := range v {
+		v[i] *= scale
+	}
+	return v
+}
+
+// add hashes feature into a dimension, with a sign from another bit of the hash so that
+// collisions cancel out on average.
+func (e *Embedder) add(v []float32, feature string) {
+	h := fnv.New64a()
+	h.Write([]byte(feature))
+	sum := h.Sum64()
+	if sum>>63 == 0 {
+		v[sum%uint64(e.dims)]++
+	} else {
+		v[sum%uint64(e.dims)]--
+	}
+}
@@ -0,0 +1,76 @@
+package ollama
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"myawesomelist.shikanime.studio/internal/agent"
+)
+
+const DefaultBaseURL = "http://localhost:11434"
+
+// Embedder embeds texts with the embed endpoint of an Ollama server.
+type Embedder struct {
+	c       *http.Client
+	baseURL string
+	model   string
+}
+
+var _ agent.Embedder = (*Embedder)(nil)
+
+// EmbedderOptions configures the Ollama embedder.
+type EmbedderOptions struct {
+	client  *http.Client
+	baseURL string
+}
+
+// EmbedderOption applies a configuration to EmbedderOptions.
+type EmbedderOption func(*EmbedderOptions)
+
+// WithHTTPClient sets the HTTP client used for API calls.
+func WithHTTPClient(c *http.Client) EmbedderOption {
+	return func(o *EmbedderOptions) { o.client = c }
+}
+
+// WithBaseURL sets the base URL of the Ollama server.
+func WithBaseURL(u string) EmbedderOption {
+	return func(o *EmbedderOptions) {
+		if u != "" {
+			o.baseURL = u
+		}
+	}
+}
+
+// NewEmbedder constructs an Embedder calling model with the given options.
+func NewEmbedder(model string, opts ...EmbedderOption) *Embedder {
+	o := EmbedderOptions{client: http.DefaultClient, baseURL: DefaultBaseURL}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Embedder{c: o.client, baseURL: strings.TrimSuffix(o.baseURL, "/"), model: model}
+}
+
+func (e *Embedder) Model() string { return e.model }
+
+type embedRequest struct {
+	Model string   `json:"model"`
+	Input []string `json:"input"`
+}
+
+type embedResponse struct {
+	Embeddings [][]float32 `json:"embeddings"`
+}
+
+// Embed embeds inputs in a single request.
+func (e *Embedder) Embed(ctx context.Context, inputs []string) ([][]float32, error) {
+	var res embedResponse
+	if err := agent.PostJSON(ctx, e.c, e.baseURL+"/api/embed", embedRequest{Model: e.model, Input: inputs}, &res); err != nil {
+		return nil, err
+	}
+	if len(res.Embeddings) != len(inputs) {
+		return nil, fmt.Errorf("expected %d embeddings, got %d", len(inputs), len(res.Embeddings))
+	}
+	return res.Embeddings, nil
+}
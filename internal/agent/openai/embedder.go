@@ -0,0 +1,51 @@
+package openai
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/openai/openai-go/v3"
+	"myawesomelist.shikanime.studio/internal/agent"
+)
+
+// Embedder embeds texts with the embeddings endpoint of an OpenAI-compatible API, such as
+// OpenAI, Scaleway or Text Embeddings Inference.
+type Embedder struct {
+	c     *sdk.Client
+	model string
+}
+
+var _ agent.Embedder = (*Embedder)(nil)
+
+// NewEmbedder constructs an Embedder calling model with c.
+func NewEmbedder(c *sdk.Client, model string) *Embedder {
+	return &Embedder{c: c, model: model}
+}
+
+func (e *Embedder) Model() string { return e.model }
+
+// Embed embeds inputs in a single request.
+func (e *Embedder) Embed(ctx context.Context, inputs []string) ([][]float32, error) {
+	res, err := e.c.Embeddings.New(ctx, sdk.EmbeddingNewParams{
+		Input: sdk.EmbeddingNewParamsInputUnion{OfArrayOfStrings: inputs},
+		Model: sdk.EmbeddingModel(e.model),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Data) != len(inputs) {
+		return nil, fmt.Errorf("expected %d embeddings, got %d", len(inputs), len(res.Data))
+	}
+	out := make([][]float32, len(inputs))
+	for _, d := range res.Data {
+		if d.Index < 0 || int(d.Index) >= len(inputs) {
+			return nil, fmt.Errorf("embedding index %d out of range", d.Index)
+		}
+		v := make([]float32, len(d.Embedding))
+		for j := range v {
+			v[j] = float32(d.Embedding[j])
+		}
+		out[d.Index] = v
+	}
+	return out, nil
+}
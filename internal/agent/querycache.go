@@ -0,0 +1,203 @@
+package agent
+
+import (
+	"container/list"
+	"context"
+	"log/slog"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultQueryCacheSize is the default number of query embeddings kept in memory.
+const DefaultQueryCacheSize = 1024
+
+// Query cache lookup results, recorded as the result attribute of the lookups counter.
+const (
+	QueryCacheMemoryHit = "memory_hit"
+	QueryCacheStoreHit  = "store_hit"
+	QueryCacheShared    = "shared"
+	QueryCacheMiss      = "miss"
+)
+
+// QueryCacheStore persists query embeddings beyond the process and across replicas.
+type QueryCacheStore interface {
+	// GetQueryEmbedding returns the embedding of query by model, or nil when it is not stored.
+	GetQueryEmbedding(ctx context.Context, model, query string) ([]float32, error)
+	// PutQueryEmbedding stores the embedding of query by model.
+	PutQueryEmbedding(ctx context.Context, model, query string, vec []float32) error
+}
+
+type queryCacheKey struct {
+	model string
+	query string
+}
+
+type queryCacheEntry struct {
+	key queryCacheKey
+	vec []float32
+}
+
+// QueryCache caches the embeddings of search queries in a bounded LRU, optionally backed by a
+// QueryCacheStore. Concurrent lookups of the same query share a single embeddings request.
+type QueryCache struct {
+	mu      sync.Mutex
+	size    int
+	ll      *list.List
+	items   map[queryCacheKey]*list.Element
+	store   QueryCacheStore
+	g       singleflight.Group
+	lookups metric.Int64Counter
+}
+
+type QueryCacheOptions struct {
+	size  int
+	store QueryCacheStore
+}
+type QueryCacheOption func(*QueryCacheOptions)
+
+// WithQueryCacheSize sets the number of query embeddings kept in memory.
+func WithQueryCacheSize(n int) QueryCacheOption {
+	return func(o *QueryCacheOptions) {
+		if n > 0 {
+			o.size = n
+		}
+	}
+}
+
+// WithQueryCacheStore persists query embeddings in s.
+func WithQueryCacheStore(s QueryCacheStore) QueryCacheOption {
+	return func(o *QueryCacheOptions) { o.store = s }
+}
+
+// NewQueryCache constructs a QueryCache. Lookups are counted by result on the
+// myawesomelist.query_embedding_cache.lookups counter of the global meter provider.
+func NewQueryCache(opts ...QueryCacheOption) *QueryCache {
+	o := QueryCacheOptions{size: DefaultQueryCacheSize}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	lookups, err := otel.Meter("myawesomelist/agent").Int64Counter(
+		"myawesomelist.query_embedding_cache.lookups",
+		metric.WithDescription("Query embedding cache lookups by result"),
+	)
+	if err != nil {
+		slog.Warn("failed to create query embedding cache counter", "error", err)
+	}
+	slog.Debug("query embedding cache configured", "size", o.size, "store", o.store != nil)
+	return &QueryCache{
+		size:    o.size,
+		ll:      list.New(),
+		items:   make(map[queryCacheKey]*list.Element),
+		store:   o.store,
+		lookups: lookups,
+	}
+}
+
+// NormalizeQuery trims, lowercases and collapses the whitespace of a search query, so that
+// queries differing only by case or spacing share an embedding.
+func NormalizeQuery(q string) string { return strings.ToLower(strings.Join(strings.Fields(q), " ")) }
+
+// Embed returns the embedding of the normalized query by model, from memory, from the store, or
+// else from embed called with the normalized query. Embeddings are computed without the
+// cancellation of ctx, so that a caller giving up does not fail the callers sharing its request.
+func (c *QueryCache) Embed(
+	ctx context.Context,
+	model, query string,
+	embed func(ctx context.Context, text string) ([]float32, error),
+) ([]float32, error) {
+	tracer := otel.Tracer("myawesomelist/agent")
+	ctx, span := tracer.Start(ctx, "QueryCache.Embed")
+	defer span.End()
+	key := queryCacheKey{model: model, query: NormalizeQuery(query)}
+	if vec, ok := c.get(key); ok {
+		c.record(ctx, span, QueryCacheMemoryHit)
+		return vec, nil
+	}
+	ch := c.g.DoChan(key.model+"\x00"+key.query, func() (any, error) {
+		ctx := context.WithoutCancel(ctx)
+		if c.store != nil {
+			vec, err := c.store.GetQueryEmbedding(ctx, key.model, key.query)
+			if err != nil {
+				slog.WarnContext(ctx, "load query embedding failed", "error", err)
+			} else if vec != nil {
+				c.add(key, vec)
+				return queryCacheResult{vec: vec, result: QueryCacheStoreHit}, nil
+			}
+		}
+		vec, err := embed(ctx, key.query)
+		if err != nil {
+			return nil, err
+		}
+		c.add(key, vec)
+		if c.store != nil {
+			if err := c.store.PutQueryEmbedding(ctx, key.model, key.query, vec); err != nil {
+				slog.WarnContext(ctx, "store query embedding failed", "error", err)
+			}
+		}
+		return queryCacheResult{vec: vec, result: QueryCacheMiss}, nil
+	})
+	select {
+	case <-ctx.Done():
+		span.RecordError(ctx.Err())
+		span.SetStatus(codes.Error, ctx.Err().Error())
+		return nil, ctx.Err()
+	case res := <-ch:
+		if res.Err != nil {
+			span.RecordError(res.Err)
+			span.SetStatus(codes.Error, res.Err.Error())
+			return nil, res.Err
+		}
+		r := res.Val.(queryCacheResult)
+		if res.Shared && r.result == QueryCacheMiss {
+			r.result = QueryCacheShared
+		}
+		c.record(ctx, span, r.result)
+		return r.vec, nil
+	}
+}
+
+type queryCacheResult struct {
+	vec    []float32
+	result string
+}
+
+func (c *QueryCache) record(ctx context.Context, span trace.Span, result string) {
+	span.SetAttributes(attribute.String("result", result))
+	if c.lookups != nil {
+		c.lookups.Add(ctx, 1, metric.WithAttributes(attribute.String("result", result)))
+	}
+}
+
+func (c *QueryCache) get(key queryCacheKey) ([]float32, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	return el.Value.(*queryCacheEntry).vec, true
+}
+
+func (c *QueryCache) add(key queryCacheKey, vec []float32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if el, ok := c.items[key]; ok {
+		el.Value.(*queryCacheEntry).vec = vec
+		c.ll.MoveToFront(el)
+		return
+	}
+	c.items[key] = c.ll.PushFront(&queryCacheEntry{key: key, vec: vec})
+	for c.ll.Len() > c.size {
+		oldest := c.ll.Back()
+		c.ll.Remove(oldest)
+		delete(c.items, oldest.Value.(*queryCacheEntry).key)
+	}
+}
@@ -0,0 +1,61 @@
+package tei
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"myawesomelist.shikanime.studio/internal/agent"
+)
+
+// Embedder embeds texts with the embed endpoint of a Text Embeddings Inference server,
+// which serves a single model.
+type Embedder struct {
+	c       *http.Client
+	baseURL string
+	model   string
+}
+
+var _ agent.Embedder = (*Embedder)(nil)
+
+// EmbedderOptions configures the Text Embeddings Inference embedder.
+type EmbedderOptions struct {
+	client *http.Client
+}
+
+// EmbedderOption applies a configuration to EmbedderOptions.
+type EmbedderOption func(*EmbedderOptions)
+
+// WithHTTPClient sets the HTTP client used for API calls.
+func WithHTTPClient(c *http.Client) EmbedderOption {
+	return func(o *EmbedderOptions) { o.client = c }
+}
+
+// NewEmbedder constructs an Embedder calling the server at baseURL, which serves model.
+func NewEmbedder(baseURL, model string, opts ...EmbedderOption) *Embedder {
+	o := EmbedderOptions{client: http.DefaultClient}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Embedder{c: o.client, baseURL: strings.TrimSuffix(baseURL, "/"), model: model}
+}
+
+func (e *Embedder) Model() string { return e.model }
+
+type embedRequest struct {
+	Inputs   []string `json:"inputs"`
+	Truncate bool     `json:"truncate"`
+}
+
+// Embed embeds inputs in a single request, truncating inputs longer than the model context.
+func (e *Embedder) Embed(ctx context.Context, inputs []string) ([][]float32, error) {
+	var res [][]float32
+	if err := agent.PostJSON(ctx, e.c, e.baseURL+"/embed", embedRequest{Inputs: inputs, Truncate: true}, &res); err != nil {
+		return nil, err
+	}
+	if len(res) != len(inputs) {
+		return nil, fmt.Errorf("expected %d embeddings, got %d", len(inputs), len(res))
+	}
+	return res, nil
+}
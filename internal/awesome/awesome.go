@@ -3,15 +3,24 @@ package awesome
 import (
 	"context"
 	"fmt"
+	"log/slog"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/codes"
 	"myawesomelist.shikanime.studio/internal/agent"
+	"myawesomelist.shikanime.studio/internal/agent/hash"
+	"myawesomelist.shikanime.studio/internal/agent/ollama"
 	"myawesomelist.shikanime.studio/internal/agent/openai"
+	"myawesomelist.shikanime.studio/internal/agent/tei"
 	"myawesomelist.shikanime.studio/internal/awesome/core"
 	"myawesomelist.shikanime.studio/internal/awesome/github"
+	"myawesomelist.shikanime.studio/internal/awesome/health"
 	"myawesomelist.shikanime.studio/internal/config"
 	"myawesomelist.shikanime.studio/internal/database"
+	"myawesomelist.shikanime.studio/internal/registry"
+	"myawesomelist.shikanime.studio/internal/registry/goproxy"
+	"myawesomelist.shikanime.studio/internal/registry/hex"
+	"myawesomelist.shikanime.studio/internal/registry/npm"
 )
 
 // Awesome aggregates external clients used by the application.
@@ -23,7 +32,9 @@ type Awesome struct {
 // ClientSetOptions holds configuration for initializing Awesome.
 type ClientSetOptions struct {
 	github     []github.GitHubClientOption
+	embedder   agent.Embedder
 	embeddings []agent.EmbeddingsOption
+	queryCache *agent.QueryCache
 }
 
 // ClientSetOption applies a configuration to ClientSetOptions.
@@ -34,7 +45,17 @@ func WithGitHubOptions(opts ...github.GitHubClientOption) ClientSetOption {
 	return func(o *ClientSetOptions) { o.github = append(o.github, opts...) }
 }
 
-// WithEmbeddingsOptions forwards OpenAI embeddings options into the Awesome configuration.
+// WithEmbedder sets the embeddings backend of the agent.
+func WithEmbedder(e agent.Embedder) ClientSetOption {
+	return func(o *ClientSetOptions) { o.embedder = e }
+}
+
+// WithQueryCache sets the cache of search query embeddings shared by the agents.
+func WithQueryCache(c *agent.QueryCache) ClientSetOption {
+	return func(o *ClientSetOptions) { o.queryCache = c }
+}
+
+// WithEmbeddingsOptions forwards embeddings options into the Awesome configuration.
 func WithEmbeddingsOptions(opts ...agent.EmbeddingsOption) ClientSetOption {
 	return func(o *ClientSetOptions) { o.embeddings = append(o.embeddings, opts...) }
 }
@@ -44,8 +65,25 @@ func NewForConfig(cfg *config.Config) (*Awesome, error) {
 	if err := cfg.Bind(); err != nil {
 		return nil, err
 	}
-	var opts []ClientSetOption
-	if token := cfg.GetOpenAIAPIKey(); token != "" {
+	docText := cfg.GetEmbeddingDocumentTemplate()
+	if docText == "" {
+		docText = agent.DefaultDocumentTemplate
+	}
+	doc, err := agent.NewDocumentTemplate(docText, cfg.GetEmbeddingReadmeExcerptLen())
+	if err != nil {
+		return nil, err
+	}
+	opts := []ClientSetOption{
+		WithEmbeddingsOptions(
+			agent.WithBatchTokens(cfg.GetEmbeddingBatchTokens()),
+			agent.WithMaxInputTokens(cfg.GetEmbeddingMaxInputTokens()),
+			agent.WithDocumentTemplate(doc),
+		),
+	}
+	if e, err := newEmbedderForConfig(cfg); err == nil {
+		opts = append(opts, WithEmbedder(e))
+	}
+	if token := cfg.GetOpenAIAPIKey(); token != "" && cfg.GetEmbeddingBackend() == "openai" {
 		opts = append(
 			opts,
 			WithEmbeddingsOptions(
@@ -61,6 +99,7 @@ func NewForConfig(cfg *config.Config) (*Awesome, error) {
 				github.WithLimiter(github.NewGitHubLimiter(true)),
 				github.WithCollectionCacheTTL(cfg.GetCollectionCacheTTL()),
 				github.WithProjectStatsTTL(cfg.GetProjectStatsTTL()),
+				github.WithNegativeCacheTTL(cfg.GetNegativeCacheTTL()),
 			),
 		)
 	}
@@ -68,6 +107,11 @@ func NewForConfig(cfg *config.Config) (*Awesome, error) {
 	if err != nil {
 		return nil, err
 	}
+	cacheOpts := []agent.QueryCacheOption{agent.WithQueryCacheSize(cfg.GetQueryEmbeddingCacheSize())}
+	if cfg.GetQueryEmbeddingCachePersist() {
+		cacheOpts = append(cacheOpts, agent.WithQueryCacheStore(core.NewQueryCacheStore(db)))
+	}
+	opts = append(opts, WithQueryCache(agent.NewQueryCache(cacheOpts...)))
 	return New(db, opts...), nil
 }
 
@@ -85,22 +129,92 @@ func NewForConfigWithOptions(cfg *config.Config, opts ...ClientSetOption) (*Awes
 }
 
 // New constructs an Awesome with the given database and options.
+// Without a query cache, search query embeddings are cached in memory with the default size.
 func New(db *database.Database, opts ...ClientSetOption) *Awesome {
 	var o ClientSetOptions
 	for _, opt := range opts {
 		opt(&o)
 	}
+	if o.queryCache == nil {
+		o.queryCache = agent.NewQueryCache()
+	}
 	return &Awesome{db: db, opts: o}
 }
 
+// Database returns the datastore backing the clients.
+func (aw *Awesome) Database() *database.Database {
+	return aw.db
+}
+
 // GitHub returns the configured GitHub client, or nil if not set.
 func (aw *Awesome) GitHub() *github.Client {
 	return github.NewClient(aw.db, aw.opts.github...)
 }
 
+// Health returns a client computing repository health scores.
+func (aw *Awesome) Health() *health.Client {
+	return health.NewClient(aw.db)
+}
+
+// Registry returns a client refreshing package registry stats with the npm, Hex.pm and Go proxy providers.
+func (aw *Awesome) Registry() *registry.Client {
+	return registry.NewClient(
+		aw.db,
+		npm.NewProvider(),
+		hex.NewProvider(),
+		goproxy.NewProvider(),
+	)
+}
+
+// Agent returns a client for embedding-backed operations, using the configured embedder or else
+// the embeddings backend of the environment.
 func (aw *Awesome) Agent() *core.Agent {
-	emb := agent.NewEmbeddingsForConfig(config.New(), aw.opts.embeddings...)
-	return core.NewAgentClient(aw.db, emb)
+	if aw.opts.embedder != nil {
+		return core.NewAgentClient(
+			aw.db,
+			agent.NewEmbeddings(aw.opts.embedder, aw.opts.embeddings...),
+			core.WithQueryCache(aw.opts.queryCache),
+		)
+	}
+	cfg := config.New()
+	if err := cfg.Bind(); err != nil {
+		slog.Warn("failed to bind config", "error", err)
+	}
+	e, err := newEmbedderForConfig(cfg)
+	if err != nil {
+		slog.Warn("embeddings not configured; search falls back to full-text", "error", err)
+		return core.NewAgentClient(aw.db, nil)
+	}
+	return core.NewAgentClient(
+		aw.db,
+		agent.NewEmbeddings(e, aw.opts.embeddings...),
+		core.WithQueryCache(aw.opts.queryCache),
+	)
+}
+
+// newEmbedderForConfig selects the embeddings backend from cfg.
+func newEmbedderForConfig(cfg *config.Config) (agent.Embedder, error) {
+	switch backend := cfg.GetEmbeddingBackend(); backend {
+	case "openai":
+		if cfg.GetOpenAIAPIKey() == "" {
+			return nil, fmt.Errorf("OPENAI_API_KEY is required by the openai embeddings backend")
+		}
+		return openai.NewEmbedder(openai.NewClientForConfig(cfg), cfg.GetEmbeddingModel()), nil
+	case "ollama":
+		if cfg.GetEmbeddingModel() == "" {
+			return nil, fmt.Errorf("EMBEDDING_MODEL is required by the ollama embeddings backend")
+		}
+		return ollama.NewEmbedder(cfg.GetEmbeddingModel(), ollama.WithBaseURL(cfg.GetEmbeddingBaseURL())), nil
+	case "tei":
+		if cfg.GetEmbeddingBaseURL() == "" {
+			return nil, fmt.Errorf("EMBEDDING_BASE_URL is required by the tei embeddings backend")
+		}
+		return tei.NewEmbedder(cfg.GetEmbeddingBaseURL(), cfg.GetEmbeddingModel()), nil
+	case "hash":
+		return hash.NewEmbedder(cfg.GetEmbeddingDimensions()), nil
+	default:
+		return nil, fmt.Errorf("unknown embeddings backend %q", backend)
+	}
 }
 
 func (aw *Awesome) Close() error {
@@ -0,0 +1,317 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"myawesomelist.shikanime.studio/internal/agent"
+	"myawesomelist.shikanime.studio/internal/database"
+	myawesomelistv1 "myawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1"
+)
+
+// Agent runs embedding-backed operations against the datastore.
+type Agent struct {
+	db    *database.Database
+	emb   *agent.Embeddings
+	cache *agent.QueryCache
+}
+
+// AgentOptions holds configuration for initializing an Agent.
+type AgentOptions struct {
+	cache *agent.QueryCache
+}
+
+// AgentOption applies a configuration to AgentOptions.
+type AgentOption func(*AgentOptions)
+
+// WithQueryCache caches the embeddings of search queries in c.
+func WithQueryCache(c *agent.QueryCache) AgentOption {
+	return func(o *AgentOptions) { o.cache = c }
+}
+
+// NewAgentClient constructs an Agent with the given datastore and embeddings client.
+// A nil embeddings client restricts search to full-text matching.
+func NewAgentClient(db *database.Database, emb *agent.Embeddings, opts ...AgentOption) *Agent {
+	var o AgentOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Agent{db: db, emb: emb, cache: o.cache}
+}
+
+// SearchProjects embeds the query and returns the projects from the datastore ranked by both
+// full-text relevance and embedding similarity. Without an embeddings client, or when embedding
+// the query fails, projects are ranked by full-text relevance only.
+func (a *Agent) SearchProjects(
+	ctx context.Context,
+	req *myawesomelistv1.SearchProjectsRequest,
+) (*database.SearchProjectsResult, error) {
+	tracer := otel.Tracer("myawesomelist/core")
+	ctx, span := tracer.Start(ctx, "Agent.SearchProjects")
+	span.SetAttributes(
+		attribute.Int("query_len", len(req.GetQuery())),
+		attribute.Int("repos_len", len(req.GetRepos())),
+	)
+	defer span.End()
+	var embeddings [][]float32
+	if q := req.GetQuery(); q != "" && a.emb != nil && (req.SemanticWeight == nil || req.GetSemanticWeight() != 0) {
+		vec, err := a.embedQuery(ctx, q)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				span.RecordError(ctxErr)
+				span.SetStatus(codes.Error, ctxErr.Error())
+				return nil, ctxErr
+			}
+			span.RecordError(err)
+			slog.WarnContext(ctx, "embed query failed; fall back to full-text search", "error", err)
+		} else {
+			embeddings = [][]float32{vec}
+		}
+	}
+	span.SetAttributes(attribute.Bool("lexical_only", len(embeddings) == 0))
+	var pushedAfter *time.Time
+	if req.PushedAfter != nil {
+		t := req.GetPushedAfter().AsTime()
+		pushedAfter = &t
+	}
+	return a.db.SearchProjects(ctx, database.SearchProjectsArgs{
+		Query:           req.GetQuery(),
+		Embeddings:      embeddings,
+		EmbeddingModel:  a.model(),
+		SemanticWeight:  req.SemanticWeight,
+		LexicalWeight:   req.LexicalWeight,
+		Limit:           req.GetPageSize(),
+		PageToken:       req.GetPageToken(),
+		Repos:           req.GetRepos(),
+		IncludeRemoved:  req.GetIncludeRemoved(),
+		Language:        req.GetLanguage(),
+		Category:        req.GetCategory(),
+		MinStars:        req.MinStars,
+		MaxStars:        req.MaxStars,
+		PushedAfter:     pushedAfter,
+		License:         req.GetLicense(),
+		Host:            req.GetHost(),
+		ExcludeArchived: req.GetExcludeArchived(),
+	})
+}
+
+// embedQuery embeds a search query, through the query cache when configured.
+func (a *Agent) embedQuery(ctx context.Context, q string) ([]float32, error) {
+	embed := func(ctx context.Context, text string) ([]float32, error) {
+		vecs, err := a.emb.EmbedTexts(ctx, []string{text})
+		if err != nil {
+			return nil, err
+		}
+		return vecs[0], nil
+	}
+	if a.cache == nil {
+		return embed(ctx, q)
+	}
+	return a.cache.Embed(ctx, a.emb.Model(), q, embed)
+}
+
+// model names the model of the embeddings client, or is empty without one.
+func (a *Agent) model() string {
+	if a.emb == nil {
+		return ""
+	}
+	return a.emb.Model()
+}
+
+// FindSimilarProjects returns the projects nearest to a project from their stored embeddings,
+// without calling the embeddings API. Embeddings are compared within the configured model or,
+// without an embeddings client, within the model embedding the project.
+func (a *Agent) FindSimilarProjects(
+	ctx context.Context,
+	req *myawesomelistv1.FindSimilarProjectsRequest,
+) ([]*myawesomelistv1.SimilarProject, error) {
+	tracer := otel.Tracer("myawesomelist/core")
+	ctx, span := tracer.Start(ctx, "Agent.FindSimilarProjects")
+	span.SetAttributes(attribute.Int64("project_id", int64(req.GetProjectId())))
+	defer span.End()
+	projects, err := a.db.FindSimilarProjects(ctx, database.FindSimilarProjectsArgs{
+		ProjectID:            req.GetProjectId(),
+		Model:                a.model(),
+		Repo:                 req.GetRepo(),
+		OtherCollectionsOnly: req.GetOtherCollectionsOnly(),
+		Languages:            req.GetLanguages(),
+		Limit:                req.GetLimit(),
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	return projects, nil
+}
+
+// UpsertAllStaledProjectEmbeddings embeds every project whose embedding from the configured model
+// is missing, was computed from another input than the current one, or is older than ttl when
+// ttl is not negative. The input is rendered by the document template of the embeddings client,
+// so changing the template embeds every project again. Unchanged projects cost no embeddings
+// request, so the job can run often.
+// Embeddings from other models are kept, so that a new model can be backfilled while search
+// still uses the previous one.
+func (a *Agent) UpsertAllStaledProjectEmbeddings(ctx context.Context, ttl time.Duration) error {
+	tracer := otel.Tracer("myawesomelist/core")
+	ctx, span := tracer.Start(ctx, "Agent.UpsertAllStaledProjectEmbeddings")
+	defer span.End()
+	if a.emb == nil {
+		err := fmt.Errorf("embeddings not configured")
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	model := a.emb.Model()
+	inputs, err := a.db.ListProjectEmbeddingInputs(
+		ctx,
+		database.ListProjectEmbeddingInputsArgs{Model: model, TTL: ttl},
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	var staled []database.ProjectEmbeddingInputResult
+	var texts, hashes []string
+	for _, in := range inputs {
+		text, err := a.emb.Document(agent.ProjectDocument{
+			Name:        in.Name,
+			Description: in.Description,
+			Collection:  in.Collection,
+			Category:    in.Category,
+			Language:    in.Language,
+			Topics:      in.Topics,
+			Readme:      in.Readme,
+		})
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+		hash := agent.InputHash(model, text)
+		if in.InputHash != nil && *in.InputHash == hash && !in.Expired {
+			continue
+		}
+		staled = append(staled, in)
+		texts = append(texts, text)
+		hashes = append(hashes, hash)
+	}
+	span.SetAttributes(
+		attribute.Int("projects_len", len(inputs)),
+		attribute.Int("staled_len", len(staled)),
+	)
+	slog.InfoContext(ctx, "embedding staled projects", "model", model, "count", len(staled), "unchanged", len(inputs)-len(staled))
+	if len(staled) == 0 {
+		return nil
+	}
+	vecs, err := a.emb.EmbedTexts(ctx, texts)
+	if vecs == nil && err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	if err != nil {
+		span.RecordError(err)
+		var failed int
+		for i, vec := range vecs {
+			if vec == nil {
+				failed++
+				slog.WarnContext(ctx, "embed project failed", "project_id", staled[i].ID, "owner", staled[i].Owner, "repo", staled[i].Repo)
+			}
+		}
+		span.SetAttributes(attribute.Int("failed_len", failed))
+		slog.WarnContext(ctx, "some projects could not be embedded", "failed", failed, "error", err)
+	}
+	for i := range staled {
+		if vecs[i] == nil {
+			continue
+		}
+		if err := a.db.UpsertProjectEmbedding(
+			ctx,
+			database.UpsertProjectEmbeddingArgs{
+				ProjectID: staled[i].ID,
+				Model:     model,
+				InputHash: hashes[i],
+				Vec:       vecs[i],
+			},
+		); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+	}
+	return nil
+}
+
+// AdoptLegacyProjectEmbeddings records the configured model as the model of the embeddings
+// stored before models were recorded, provided the model yields embeddings of the same
+// dimensions. It embeds a probe text to learn the dimensions.
+func (a *Agent) AdoptLegacyProjectEmbeddings(ctx context.Context) error {
+	tracer := otel.Tracer("myawesomelist/core")
+	ctx, span := tracer.Start(ctx, "Agent.AdoptLegacyProjectEmbeddings")
+	defer span.End()
+	if a.emb == nil {
+		err := fmt.Errorf("embeddings not configured")
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	probe, err := a.emb.EmbedTexts(ctx, []string{"myawesomelist"})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("probe embedding dimensions failed: %w", err)
+	}
+	adopted, err := a.db.AdoptLegacyProjectEmbeddings(ctx, database.AdoptLegacyProjectEmbeddingsArgs{
+		Model:      a.emb.Model(),
+		Dimensions: len(probe[0]),
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	slog.InfoContext(ctx, "adopted legacy project embeddings", "model", a.emb.Model(), "dimensions", len(probe[0]), "count", adopted)
+	return nil
+}
+
+// PruneProjectEmbeddings deletes the project and query embeddings of every model but the
+// configured one, and the query embeddings unused for longer than queryTTL when it is not
+// negative.
+func (a *Agent) PruneProjectEmbeddings(ctx context.Context, queryTTL time.Duration) error {
+	tracer := otel.Tracer("myawesomelist/core")
+	ctx, span := tracer.Start(ctx, "Agent.PruneProjectEmbeddings")
+	defer span.End()
+	if a.emb == nil {
+		err := fmt.Errorf("embeddings not configured")
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	deleted, err := a.db.DeleteProjectEmbeddings(ctx, database.DeleteProjectEmbeddingsArgs{
+		KeepModel: a.emb.Model(),
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	slog.InfoContext(ctx, "pruned project embeddings", "kept_model", a.emb.Model(), "count", deleted)
+	deleted, err = a.db.DeleteQueryEmbeddings(ctx, database.DeleteQueryEmbeddingsArgs{
+		KeepModel: a.emb.Model(),
+		UnusedFor: queryTTL,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	slog.InfoContext(ctx, "pruned query embeddings", "kept_model", a.emb.Model(), "count", deleted)
+	return nil
+}
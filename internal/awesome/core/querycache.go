@@ -0,0 +1,31 @@
+package core
+
+import (
+	"context"
+
+	"myawesomelist.shikanime.studio/internal/agent"
+	"myawesomelist.shikanime.studio/internal/database"
+)
+
+// queryCacheStore persists query embeddings in the datastore.
+type queryCacheStore struct {
+	db *database.Database
+}
+
+var _ agent.QueryCacheStore = (*queryCacheStore)(nil)
+
+// NewQueryCacheStore returns a store persisting query embeddings in db.
+func NewQueryCacheStore(db *database.Database) agent.QueryCacheStore {
+	return &queryCacheStore{db: db}
+}
+
+func (s *queryCacheStore) GetQueryEmbedding(ctx context.Context, model, query string) ([]float32, error) {
+	return s.db.GetQueryEmbedding(ctx, database.GetQueryEmbeddingArgs{Model: model, Query: query})
+}
+
+func (s *queryCacheStore) PutQueryEmbedding(ctx context.Context, model, query string, vec []float32) error {
+	return s.db.UpsertQueryEmbedding(
+		ctx,
+		database.UpsertQueryEmbeddingArgs{Model: model, Query: query, Vec: vec},
+	)
+}
@@ -3,9 +3,12 @@ package github
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"net/url"
+	"slices"
 	"time"
 
 	"github.com/google/go-github/v75/github"
@@ -14,6 +17,7 @@ import (
 	"go.opentelemetry.io/otel/codes"
 	"golang.org/x/sync/errgroup"
 	"golang.org/x/time/rate"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"k8s.io/utils/ptr"
 	"myawesomelist.shikanime.studio/internal/database"
 	"myawesomelist.shikanime.studio/internal/encoding"
@@ -45,6 +49,7 @@ type Client struct {
 	d    *database.Database
 	cttl time.Duration
 	pttl time.Duration
+	nttl time.Duration
 }
 
 // GitHubClientOptions configures the GitHub client.
@@ -53,6 +58,7 @@ type GitHubClientOptions struct {
 	limiter *rate.Limiter
 	cttl    time.Duration
 	pttl    time.Duration
+	nttl    time.Duration
 }
 
 // GitHubClientOption applies a configuration to GitHubClientOptions.
@@ -78,6 +84,12 @@ func WithProjectStatsTTL(d time.Duration) GitHubClientOption {
 	return func(o *GitHubClientOptions) { o.pttl = d }
 }
 
+// WithNegativeCacheTTL sets how long unavailable repositories (not found, blocked, disabled)
+// are served from the datastore before being checked again; zero means infinite (no refresh).
+func WithNegativeCacheTTL(d time.Duration) GitHubClientOption {
+	return func(o *GitHubClientOptions) { o.nttl = d }
+}
+
 // NewClient constructs a GitHub Client with the given datastore and options.
 func NewClient(db *database.Database, opts ...GitHubClientOption) *Client {
 	var o GitHubClientOptions
@@ -92,10 +104,18 @@ func NewClient(db *database.Database, opts ...GitHubClientOption) *Client {
 			d:    db,
 			cttl: o.cttl,
 			pttl: o.pttl,
+			nttl: o.nttl,
 		}
 	}
 	slog.Warn("Using unauthenticated GitHub client (rate limited)")
-	return &Client{c: github.NewClient(nil), l: o.limiter, d: db, cttl: o.cttl, pttl: o.pttl}
+	return &Client{
+		c:    github.NewClient(nil),
+		l:    o.limiter,
+		d:    db,
+		cttl: o.cttl,
+		pttl: o.pttl,
+		nttl: o.nttl,
+	}
 }
 
 // GetReadme retrieves and decodes the README.md file for the given repository.
@@ -118,9 +138,81 @@ func (c *Client) GetReadme(ctx context.Context, repo *myawesomelistv1.Repository
 	return base64.StdEncoding.DecodeString(*file.Content)
 }
 
+// getProjectReadme retrieves the preferred README of a repository, whatever its name or format.
+// It returns an empty README when the repository has none.
+func (c *Client) getProjectReadme(
+	ctx context.Context,
+	repo *myawesomelistv1.Repository,
+) (string, error) {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.getProjectReadme")
+	span.SetAttributes(attribute.String("owner", repo.Owner), attribute.String("repo", repo.Repo))
+	defer span.End()
+	if err := c.l.Wait(ctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return "", fmt.Errorf("rate limiter wait failed: %w", err)
+	}
+	file, resp, err := c.c.Repositories.GetReadme(ctx, repo.Owner, repo.Repo, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return "", nil
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return "", fmt.Errorf("failed to get readme for %s/%s: %w", repo.Owner, repo.Repo, err)
+	}
+	return file.GetContent()
+}
+
+// UpsertAllStaledProjectReadmes fetches the README of every listed GitHub project whose stored README
+// is missing or older than ttl (negative disables the TTL) and stores it in project metadata.
+// Failures on a single repository are logged and skipped.
+func (c *Client) UpsertAllStaledProjectReadmes(ctx context.Context, ttl time.Duration) error {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.UpsertAllStaledProjectReadmes")
+	defer span.End()
+	staled, err := c.d.ListStaledProjectMetadata(
+		ctx,
+		database.ListStaledProjectMetadataArgs{Hostname: "github.com", TTL: ttl},
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("failed to list staled project metadata: %w", err)
+	}
+	span.SetAttributes(attribute.Int("staled", len(staled)))
+	slog.InfoContext(ctx, "Fetching project READMEs", "count", len(staled))
+	var failed int
+	for _, r := range staled {
+		repo := &myawesomelistv1.Repository{Hostname: r.Hostname, Owner: r.Owner, Repo: r.Repo}
+		readme, err := c.getProjectReadme(ctx, repo)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				span.RecordError(ctxErr)
+				span.SetStatus(codes.Error, ctxErr.Error())
+				return ctxErr
+			}
+			failed++
+			slog.WarnContext(ctx, "Failed to fetch project README", "hostname", r.Hostname, "owner", r.Owner, "repo", r.Repo, "error", err)
+			continue
+		}
+		if err := c.d.UpsertProjectMetadata(ctx, database.UpsertProjectMetadataArgs{RepositoryID: r.RepositoryID, Readme: readme}); err != nil {
+			failed++
+			slog.WarnContext(ctx, "Failed to upsert project metadata", "hostname", r.Hostname, "owner", r.Owner, "repo", r.Repo, "error", err)
+		}
+	}
+	span.SetAttributes(attribute.Int("failed", failed))
+	slog.InfoContext(ctx, "Fetched project READMEs", "count", len(staled), "failed", failed)
+	return nil
+}
+
 type GetCollectionOption func(*getCollectionOptions)
 
-type getCollectionOptions struct{ eopts []encoding.Option }
+type getCollectionOptions struct {
+	eopts          []encoding.Option
+	includeRemoved bool
+}
 
 func WithStartSection(section string) GetCollectionOption {
 	return func(o *getCollectionOptions) { o.eopts = append(o.eopts, encoding.WithStartSection(section)) }
@@ -132,6 +224,11 @@ func WithSubsectionAsCategory() GetCollectionOption {
 	return func(o *getCollectionOptions) { o.eopts = append(o.eopts, encoding.WithSubsectionAsCategory()) }
 }
 
+// WithIncludeRemoved also returns the categories and projects dropped from the README.
+func WithIncludeRemoved() GetCollectionOption {
+	return func(o *getCollectionOptions) { o.includeRemoved = true }
+}
+
 // ListCollections returns collections for the requested repositories, fetching from GitHub if not cached.
 func (c *Client) ListCollections(
 	ctx context.Context,
@@ -208,6 +305,91 @@ func (c *Client) ListCollections(
 	return cols, nil
 }
 
+// ListCollectionsPage returns a page of the collections of repos from the datastore. The first page
+// fetches the collections missing from the datastore first.
+func (c *Client) ListCollectionsPage(
+	ctx context.Context,
+	args database.ListCollectionsPageArgs,
+	opts ...GetCollectionOption,
+) (*database.ListCollectionsPageResult, error) {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.ListCollectionsPage")
+	span.SetAttributes(attribute.Int("repos_len", len(args.Repos)))
+	defer span.End()
+	if args.PageToken == "" {
+		if _, err := c.ListCollections(ctx, args.Repos, opts...); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+	}
+	page, err := c.d.ListCollectionsPage(ctx, args)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("failed to list collections: %w", err)
+	}
+	return page, nil
+}
+
+// ListCategories returns a page of the categories of a collection from the datastore. The first
+// page refreshes the collection first, honoring cache TTL semantics.
+func (c *Client) ListCategories(
+	ctx context.Context,
+	args database.ListCategoriesPageArgs,
+	opts ...GetCollectionOption,
+) (*database.ListCategoriesPageResult, error) {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.ListCategories")
+	span.SetAttributes(attribute.String("owner", args.Repo.Owner), attribute.String("repo", args.Repo.Repo))
+	defer span.End()
+	if args.PageToken == "" {
+		if _, err := c.GetCollection(ctx, args.Repo, opts...); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+	}
+	page, err := c.d.ListCategoriesPage(ctx, args)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("failed to list categories of %s/%s: %w", args.Repo.Owner, args.Repo.Repo, err)
+	}
+	return page, nil
+}
+
+// ListProjects returns a page of the projects of a collection category from the datastore. The
+// first page refreshes the collection first, honoring cache TTL semantics.
+func (c *Client) ListProjects(
+	ctx context.Context,
+	args database.ListProjectsPageArgs,
+	opts ...GetCollectionOption,
+) (*database.ListProjectsPageResult, error) {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.ListProjects")
+	span.SetAttributes(
+		attribute.String("owner", args.Repo.Owner),
+		attribute.String("repo", args.Repo.Repo),
+		attribute.String("category", args.CategoryName),
+	)
+	defer span.End()
+	if args.PageToken == "" {
+		if _, err := c.GetCollection(ctx, args.Repo, opts...); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+	}
+	page, err := c.d.ListProjectsPage(ctx, args)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("failed to list projects of %s/%s: %w", args.Repo.Owner, args.Repo.Repo, err)
+	}
+	return page, nil
+}
+
 // GetCollection returns a single collection, honoring cache TTL semantics (zero TTL disables refresh).
 func (c *Client) GetCollection(
 	ctx context.Context,
@@ -222,7 +404,10 @@ func (c *Client) GetCollection(
 	for _, opt := range opts {
 		opt(options)
 	}
-	col, err := c.d.GetCollection(ctx, repo)
+	col, err := c.d.GetCollection(
+		ctx,
+		database.GetCollectionArgs{Repo: repo, IncludeRemoved: options.includeRemoved},
+	)
 	if err != nil {
 		slog.WarnContext(
 			ctx,
@@ -265,26 +450,23 @@ func (c *Client) GetCollection(
 				"ttl", ttl,
 			)
 		} else {
+			slog.InfoContext(
+				ctx,
+				"Retrieved collection from datastore cache",
+				"hostname",
+				repo.Hostname,
+				"owner",
+				repo.Owner,
+				"repo",
+				repo.Repo,
+				"categories",
+				len(col.Categories),
+				"updated_at",
+				col.UpdatedAt.AsTime(),
+			)
 			return col, nil
 		}
 	}
-	if col != nil {
-		slog.InfoContext(
-			ctx,
-			"Retrieved collection from datastore cache",
-			"hostname",
-			repo.Hostname,
-			"owner",
-			repo.Owner,
-			"repo",
-			repo.Repo,
-			"categories",
-			len(col.Categories),
-			"updated_at",
-			col.UpdatedAt.AsTime(),
-		)
-		return col, nil
-	}
 	slog.InfoContext(
 		ctx,
 		"Fetching collection from GitHub API",
@@ -376,8 +558,34 @@ func (c *Client) GetCollection(
 		)
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
+		return colProto, nil
+	}
+	// Read back the stored collection to return datastore IDs and project stats.
+	stored, err := c.d.GetCollection(
+		ctx,
+		database.GetCollectionArgs{Repo: repo, IncludeRemoved: options.includeRemoved},
+	)
+	if err == nil && stored != nil {
+		return stored, nil
+	}
+	return colProto, nil
+}
+
+// ListCollectionChanges lists the changes recorded between collection ingests, most recent first.
+func (c *Client) ListCollectionChanges(
+	ctx context.Context,
+	args database.ListCollectionChangesArgs,
+) ([]*myawesomelistv1.CollectionEvent, error) {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.ListCollectionChanges")
+	defer span.End()
+	events, err := c.d.ListCollectionChanges(ctx, args)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("failed to list collection changes: %w", err)
 	}
-	return col, nil
+	return events, nil
 }
 
 // GetProjectStats returns repository statistics, honoring cache TTL semantics (zero TTL disables refresh).
@@ -406,6 +614,9 @@ func (c *Client) GetProjectStats(
 	}
 	if stats != nil {
 		ttl := c.pttl
+		if isRepositoryUnavailable(stats.Status) {
+			ttl = c.nttl
+		}
 		if ttl > 0 {
 			if time.Since(stats.UpdatedAt.AsTime()) < ttl {
 				slog.InfoContext(
@@ -414,6 +625,7 @@ func (c *Client) GetProjectStats(
 					"hostname", repo.Hostname,
 					"owner", repo.Owner,
 					"repo", repo.Repo,
+					"status", stats.Status,
 					"updated_at", stats.UpdatedAt.AsTime(),
 					"ttl", ttl,
 				)
@@ -437,16 +649,75 @@ func (c *Client) GetProjectStats(
 		span.SetStatus(codes.Error, err.Error())
 		return nil, fmt.Errorf("rate limiter wait failed: %w", err)
 	}
+	var statsFetched, releasesFetched bool
 	ghRepo, _, err := c.c.Repositories.Get(ctx, repo.Owner, repo.Repo)
 	if err != nil {
-		span.RecordError(err)
-		span.SetStatus(codes.Error, err.Error())
-		return nil, fmt.Errorf("failed to get repo info for %s/%s: %w", repo.Owner, repo.Repo, err)
-	}
-	stats = &myawesomelistv1.ProjectStats{
-		StargazersCount: ptr.To(uint32(ptr.Deref(ghRepo.StargazersCount, 0))),
-		OpenIssueCount:  ptr.To(uint32(ptr.Deref(ghRepo.OpenIssuesCount, 0))),
+		status, ok := repositoryStatusFromError(err)
+		if !ok {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, fmt.Errorf(
+				"failed to get repo info for %s/%s: %w",
+				repo.Owner,
+				repo.Repo,
+				err,
+			)
+		}
+		slog.InfoContext(
+			ctx,
+			"Repository unavailable on GitHub; caching status",
+			"hostname", repo.Hostname,
+			"owner", repo.Owner,
+			"repo", repo.Repo,
+			"status", status,
+			"error", err,
+		)
+		// Keep the last known stats so the tombstone still carries useful data.
+		if stats == nil {
+			stats = &myawesomelistv1.ProjectStats{}
+		}
+		stats.Status = status
+		stats.StatusCheckedAt = timestamppb.Now()
+	} else {
+		prev := stats
+		stats = projectStatsFromRepo(ghRepo)
+		statsFetched = true
+		latest, cadence, relErr := c.getLatestRelease(ctx, repo)
+		if relErr != nil {
+			slog.WarnContext(
+				ctx,
+				"Failed to fetch releases; keeping previous release",
+				"hostname", repo.Hostname,
+				"owner", repo.Owner,
+				"repo", repo.Repo,
+				"error", relErr,
+			)
+			if prev != nil {
+				stats.LatestRelease = prev.LatestRelease
+				stats.ReleaseCadenceDays = prev.ReleaseCadenceDays
+			}
+		} else {
+			stats.LatestRelease = latest
+			stats.ReleaseCadenceDays = cadence
+			releasesFetched = true
+		}
+		if err := c.getContributors(ctx, repo, stats); err != nil {
+			slog.WarnContext(
+				ctx,
+				"Failed to fetch contributors; keeping previous contributor metrics",
+				"hostname", repo.Hostname,
+				"owner", repo.Owner,
+				"repo", repo.Repo,
+				"error", err,
+			)
+			if prev != nil {
+				stats.ContributorsCount = prev.ContributorsCount
+				stats.TopContributorShare = prev.TopContributorShare
+				stats.BusFactor = prev.BusFactor
+			}
+		}
 	}
+	span.SetAttributes(attribute.String("status", stats.Status.String()))
 	rms, idErr := c.d.UpsertRepositories(
 		ctx,
 		[]*database.UpsertRepositoryArgs{
@@ -466,10 +737,328 @@ func (c *Client) GetProjectStats(
 			"error",
 			idErr,
 		)
-	} else if err := c.d.UpsertProjectStats(ctx, database.UpsertProjectStatsArgs{RepositoryID: rms[0].ID, StargazersCount: stats.StargazersCount, OpenIssueCount: stats.OpenIssueCount}); err != nil {
-		slog.WarnContext(ctx, "Failed to upsert project stats", "hostname", repo.Hostname, "owner", repo.Owner, "repo", repo.Repo, "error", err)
+	} else {
+		// The tombstone of an unavailable repository keeps stale counts, which would skew the history.
+		statsArgs := upsertProjectStatsArgs(rms[0].ID, stats)
+		statsArgs.RecordHistory = statsFetched
+		if err := c.d.UpsertProjectStats(ctx, statsArgs); err != nil {
+			slog.WarnContext(ctx, "Failed to upsert project stats", "hostname", repo.Hostname, "owner", repo.Owner, "repo", repo.Repo, "error", err)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		if err := c.d.UpdateRepositoryStatus(ctx, database.UpdateRepositoryStatusArgs{RepositoryID: rms[0].ID, Status: stats.Status}); err != nil {
+			slog.WarnContext(ctx, "Failed to update repository status", "hostname", repo.Hostname, "owner", repo.Owner, "repo", repo.Repo, "error", err)
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		if releasesFetched {
+			if err := c.d.UpsertProjectRelease(ctx, upsertProjectReleaseArgs(rms[0].ID, stats)); err != nil {
+				slog.WarnContext(ctx, "Failed to upsert project release", "hostname", repo.Hostname, "owner", repo.Owner, "repo", repo.Repo, "error", err)
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+		}
+	}
+	return stats, nil
+}
+
+// releaseCadenceWindow is the number of recent releases used to compute the release cadence.
+const releaseCadenceWindow = 10
+
+// getLatestRelease fetches the most recent published release of a repository and the mean
+// number of days between its recent releases. Both are nil when the repository has no release.
+func (c *Client) getLatestRelease(
+	ctx context.Context,
+	repo *myawesomelistv1.Repository,
+) (*myawesomelistv1.Release, *float64, error) {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.getLatestRelease")
+	span.SetAttributes(attribute.String("owner", repo.Owner), attribute.String("repo", repo.Repo))
+	defer span.End()
+	if err := c.l.Wait(ctx); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, fmt.Errorf("rate limiter wait failed: %w", err)
+	}
+	releases, _, err := c.c.Repositories.ListReleases(
+		ctx,
+		repo.Owner,
+		repo.Repo,
+		&github.ListOptions{PerPage: releaseCadenceWindow},
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, nil, fmt.Errorf(
+			"failed to list releases for %s/%s: %w",
+			repo.Owner,
+			repo.Repo,
+			err,
+		)
+	}
+	var latest *github.RepositoryRelease
+	var published []time.Time
+	for _, r := range releases {
+		if r.GetDraft() || r.PublishedAt == nil {
+			continue
+		}
+		if latest == nil || r.PublishedAt.After(latest.PublishedAt.Time) {
+			latest = r
+		}
+		published = append(published, r.PublishedAt.Time)
+	}
+	span.SetAttributes(attribute.Int("releases", len(published)))
+	if latest == nil {
+		return nil, nil, nil
+	}
+	rel := &myawesomelistv1.Release{
+		TagName:     latest.GetTagName(),
+		Name:        latest.GetName(),
+		PublishedAt: timestamppb.New(latest.PublishedAt.Time),
+		Prerelease:  latest.GetPrerelease(),
+		Url:         latest.GetHTMLURL(),
+	}
+	if len(published) < 2 {
+		return rel, nil, nil
+	}
+	slices.SortFunc(published, func(a, b time.Time) int { return a.Compare(b) })
+	elapsed := published[len(published)-1].Sub(published[0])
+	cadence := elapsed.Hours() / 24 / float64(len(published)-1)
+	return rel, &cadence, nil
+}
+
+// contributorsPageSize is the number of top contributors used to compute commit shares.
+const contributorsPageSize = 100
+
+// getContributors fills the contributor count, the top contributor commit share and the bus factor
+// of stats. Shares are computed over the top contributors page; the count is exact and costs one
+// extra request when the repository has more contributors than fit in that page.
+func (c *Client) getContributors(
+	ctx context.Context,
+	repo *myawesomelistv1.Repository,
+	stats *myawesomelistv1.ProjectStats,
+) error {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.getContributors")
+	span.SetAttributes(attribute.String("owner", repo.Owner), attribute.String("repo", repo.Repo))
+	defer span.End()
+	if err := c.l.Wait(ctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("rate limiter wait failed: %w", err)
+	}
+	contributors, resp, err := c.c.Repositories.ListContributors(
+		ctx,
+		repo.Owner,
+		repo.Repo,
+		&github.ListContributorsOptions{ListOptions: github.ListOptions{PerPage: contributorsPageSize}},
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf(
+			"failed to list contributors for %s/%s: %w",
+			repo.Owner,
+			repo.Repo,
+			err,
+		)
+	}
+	count := len(contributors)
+	if resp != nil && resp.LastPage > 1 {
+		if err := c.l.Wait(ctx); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return fmt.Errorf("rate limiter wait failed: %w", err)
+		}
+		// With one contributor per page, the last page number is the contributor count.
+		_, resp, err = c.c.Repositories.ListContributors(
+			ctx,
+			repo.Owner,
+			repo.Repo,
+			&github.ListContributorsOptions{ListOptions: github.ListOptions{PerPage: 1}},
+		)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return fmt.Errorf(
+				"failed to count contributors for %s/%s: %w",
+				repo.Owner,
+				repo.Repo,
+				err,
+			)
+		}
+		count = max(count, resp.LastPage)
+	}
+	span.SetAttributes(attribute.Int("contributors", count))
+	stats.ContributorsCount = ptr.To(uint32(count))
+	stats.TopContributorShare, stats.BusFactor = contributorShares(contributors)
+	return nil
+}
+
+// contributorShares returns the commit share of the top contributor and the smallest number of
+// contributors authoring half of the commits, or nils when there are no commits.
+func contributorShares(contributors []*github.Contributor) (*float64, *uint32) {
+	commits := make([]int, 0, len(contributors))
+	var total int
+	for _, ct := range contributors {
+		commits = append(commits, ct.GetContributions())
+		total += ct.GetContributions()
+	}
+	if total == 0 {
+		return nil, nil
+	}
+	slices.SortFunc(commits, func(a, b int) int { return b - a })
+	var busFactor uint32
+	for acc := 0; 2*acc < total; busFactor++ {
+		acc += commits[busFactor]
+	}
+	return ptr.To(float64(commits[0]) / float64(total)), &busFactor
+}
+
+// GetProjectStatsHistory returns the recorded stats time series of a repository between optional bounds.
+func (c *Client) GetProjectStatsHistory(
+	ctx context.Context,
+	repo *myawesomelistv1.Repository,
+	start, end *time.Time,
+	interval myawesomelistv1.StatsInterval,
+) ([]*myawesomelistv1.ProjectStatsPoint, error) {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.GetProjectStatsHistory")
+	span.SetAttributes(attribute.String("owner", repo.Owner), attribute.String("repo", repo.Repo))
+	defer span.End()
+	points, err := c.d.ListProjectStatsHistory(ctx, database.ListProjectStatsHistoryArgs{
+		Repo:     repo,
+		Start:    start,
+		End:      end,
+		Interval: interval,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf(
+			"failed to list stats history for %s/%s: %w",
+			repo.Owner,
+			repo.Repo,
+			err,
+		)
+	}
+	return points, nil
+}
+
+// projectStatsFromRepo converts GitHub repository metadata into project stats.
+func projectStatsFromRepo(r *github.Repository) *myawesomelistv1.ProjectStats {
+	stats := &myawesomelistv1.ProjectStats{
+		StargazersCount:  ptr.To(uint32(r.GetStargazersCount())),
+		OpenIssueCount:   ptr.To(uint32(r.GetOpenIssuesCount())),
+		ForksCount:       ptr.To(uint32(r.GetForksCount())),
+		SubscribersCount: ptr.To(uint32(r.GetSubscribersCount())),
+		License:          r.GetLicense().GetSPDXID(),
+		Topics:           r.Topics,
+		Language:         r.GetLanguage(),
+		DefaultBranch:    r.GetDefaultBranch(),
+		Archived:         r.GetArchived(),
+		Status:           repositoryStatusFromRepo(r),
+		StatusCheckedAt:  timestamppb.Now(),
+	}
+	if r.CreatedAt != nil {
+		stats.CreatedAt = timestamppb.New(r.CreatedAt.Time)
+	}
+	if r.PushedAt != nil {
+		stats.PushedAt = timestamppb.New(r.PushedAt.Time)
+	}
+	return stats
+}
+
+// upsertProjectStatsArgs converts project stats into datastore upsert arguments.
+func upsertProjectStatsArgs(
+	repositoryID uint64,
+	stats *myawesomelistv1.ProjectStats,
+) database.UpsertProjectStatsArgs {
+	args := database.UpsertProjectStatsArgs{
+		RepositoryID:        repositoryID,
+		StargazersCount:     stats.StargazersCount,
+		OpenIssueCount:      stats.OpenIssueCount,
+		ForksCount:          stats.ForksCount,
+		SubscribersCount:    stats.SubscribersCount,
+		License:             stats.License,
+		Topics:              stats.Topics,
+		Language:            stats.Language,
+		DefaultBranch:       stats.DefaultBranch,
+		Archived:            stats.Archived,
+		ContributorsCount:   stats.ContributorsCount,
+		TopContributorShare: stats.TopContributorShare,
+		BusFactor:           stats.BusFactor,
+	}
+	if stats.CreatedAt != nil {
+		args.CreatedAt = ptr.To(stats.CreatedAt.AsTime())
+	}
+	if stats.PushedAt != nil {
+		args.PushedAt = ptr.To(stats.PushedAt.AsTime())
+	}
+	return args
+}
+
+// upsertProjectReleaseArgs converts the release of project stats into datastore upsert arguments.
+func upsertProjectReleaseArgs(
+	repositoryID uint64,
+	stats *myawesomelistv1.ProjectStats,
+) database.UpsertProjectReleaseArgs {
+	args := database.UpsertProjectReleaseArgs{
+		RepositoryID: repositoryID,
+		CadenceDays:  stats.ReleaseCadenceDays,
+	}
+	if rel := stats.LatestRelease; rel != nil {
+		args.TagName = rel.TagName
+		args.Name = rel.Name
+		args.Prerelease = rel.Prerelease
+		args.URL = rel.Url
+		if rel.PublishedAt != nil {
+			args.PublishedAt = ptr.To(rel.PublishedAt.AsTime())
+		}
+	}
+	return args
+}
+
+// repositoryStatusFromRepo derives the repository status from GitHub repository metadata.
+func repositoryStatusFromRepo(r *github.Repository) myawesomelistv1.RepositoryStatus {
+	switch {
+	case r.GetDisabled():
+		return myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_DISABLED
+	case r.GetArchived():
+		return myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_ARCHIVED
+	default:
+		return myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_ACTIVE
+	}
+}
+
+// repositoryStatusFromError maps GitHub API errors that denote an unavailable repository to a status.
+// It reports false for any other error, such as rate limiting or network failures.
+func repositoryStatusFromError(err error) (myawesomelistv1.RepositoryStatus, bool) {
+	var errResp *github.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_UNSPECIFIED, false
+	}
+	if errResp.Block != nil {
+		return myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_BLOCKED, true
+	}
+	switch errResp.Response.StatusCode {
+	case http.StatusNotFound, http.StatusGone:
+		return myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_NOT_FOUND, true
+	case http.StatusUnavailableForLegalReasons:
+		return myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_BLOCKED, true
+	default:
+		return myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_UNSPECIFIED, false
+	}
+}
+
+// isRepositoryUnavailable reports whether the status denotes a repository that can no longer be fetched.
+func isRepositoryUnavailable(status myawesomelistv1.RepositoryStatus) bool {
+	switch status {
+	case myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_NOT_FOUND,
+		myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_BLOCKED,
+		myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_DISABLED:
+		return true
+	default:
+		return false
 	}
-	return stats, nil
 }
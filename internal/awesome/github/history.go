@@ -0,0 +1,326 @@
+package github
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v75/github"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"myawesomelist.shikanime.studio/internal/database"
+	"myawesomelist.shikanime.studio/internal/encoding"
+	myawesomelistv1 "myawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1"
+)
+
+// collectionReadmePath is the file collections are parsed from.
+const collectionReadmePath = "README.md"
+
+// FileCommit is a commit touching a file.
+type FileCommit struct {
+	SHA         string
+	CommittedAt time.Time
+}
+
+// CommitSource lists the revisions of a repository file and reads the file at a revision.
+type CommitSource interface {
+	// ListFileCommits lists the commits touching path committed at or after since, oldest first.
+	ListFileCommits(
+		ctx context.Context,
+		repo *myawesomelistv1.Repository,
+		path string,
+		since time.Time,
+	) ([]FileCommit, error)
+	// GetFileAt reads path at the given commit.
+	GetFileAt(ctx context.Context, repo *myawesomelistv1.Repository, path, sha string) ([]byte, error)
+}
+
+var _ CommitSource = (*Client)(nil)
+
+// ListFileCommits lists the commits touching path committed at or after since with the commits API, oldest first.
+func (c *Client) ListFileCommits(
+	ctx context.Context,
+	repo *myawesomelistv1.Repository,
+	path string,
+	since time.Time,
+) ([]FileCommit, error) {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.ListFileCommits")
+	span.SetAttributes(
+		attribute.String("owner", repo.Owner),
+		attribute.String("repo", repo.Repo),
+		attribute.String("path", path),
+	)
+	defer span.End()
+	opts := &github.CommitsListOptions{
+		Path:        path,
+		Since:       since,
+		ListOptions: github.ListOptions{PerPage: 100},
+	}
+	var out []FileCommit
+	for {
+		if err := c.l.Wait(ctx); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, fmt.Errorf("rate limiter wait failed: %w", err)
+		}
+		commits, resp, err := c.c.Repositories.ListCommits(ctx, repo.Owner, repo.Repo, opts)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, fmt.Errorf("failed to list commits of %s for %s/%s: %w", path, repo.Owner, repo.Repo, err)
+		}
+		for _, rc := range commits {
+			out = append(out, FileCommit{
+				SHA:         rc.GetSHA(),
+				CommittedAt: rc.GetCommit().GetCommitter().GetDate().Time,
+			})
+		}
+		if resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	// The commits API lists the most recent commits first.
+	slices.Reverse(out)
+	span.SetAttributes(attribute.Int("commits", len(out)))
+	return out, nil
+}
+
+// GetFileAt reads path at the given commit with the contents API.
+func (c *Client) GetFileAt(
+	ctx context.Context,
+	repo *myawesomelistv1.Repository,
+	path, sha string,
+) ([]byte, error) {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.GetFileAt")
+	span.SetAttributes(
+		attribute.String("owner", repo.Owner),
+		attribute.String("repo", repo.Repo),
+		attribute.String("path", path),
+		attribute.String("sha", sha),
+	)
+	defer span.End()
+	if err := c.l.Wait(ctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("rate limiter wait failed: %w", err)
+	}
+	file, _, _, err := c.c.Repositories.GetContents(
+		ctx,
+		repo.Owner,
+		repo.Repo,
+		path,
+		&github.RepositoryContentGetOptions{Ref: sha},
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("failed to get %s at %s for %s/%s: %w", path, sha, repo.Owner, repo.Repo, err)
+	}
+	if file == nil {
+		return nil, fmt.Errorf("%s at %s for %s/%s is not a file", path, sha, repo.Owner, repo.Repo)
+	}
+	content, err := file.GetContent()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(content), nil
+}
+
+// GitCloneSource reads file history from local clones laid out as <dir>/<owner>/<repo>,
+// which avoids spending API quota on long histories.
+type GitCloneSource struct {
+	dir string
+}
+
+var _ CommitSource = (*GitCloneSource)(nil)
+
+// NewGitCloneSource constructs a GitCloneSource reading clones under dir.
+func NewGitCloneSource(dir string) *GitCloneSource {
+	return &GitCloneSource{dir: dir}
+}
+
+func (s *GitCloneSource) git(ctx context.Context, repo *myawesomelistv1.Repository, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", filepath.Join(s.dir, repo.Owner, repo.Repo)}, args...)...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s failed: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return out, nil
+}
+
+// ListFileCommits lists the commits touching path committed at or after since with git log, oldest first.
+func (s *GitCloneSource) ListFileCommits(
+	ctx context.Context,
+	repo *myawesomelistv1.Repository,
+	path string,
+	since time.Time,
+) ([]FileCommit, error) {
+	args := []string{"log", "--reverse", "--format=%H %cI"}
+	if !since.IsZero() {
+		args = append(args, "--since="+since.Format(time.RFC3339))
+	}
+	out, err := s.git(ctx, repo, append(args, "--", path)...)
+	if err != nil {
+		return nil, err
+	}
+	var commits []FileCommit
+	sc := bufio.NewScanner(bytes.NewReader(out))
+	for sc.Scan() {
+		sha, date, ok := strings.Cut(strings.TrimSpace(sc.Text()), " ")
+		if !ok {
+			continue
+		}
+		at, err := time.Parse(time.RFC3339, date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid commit date %q: %w", date, err)
+		}
+		commits = append(commits, FileCommit{SHA: sha, CommittedAt: at})
+	}
+	return commits, sc.Err()
+}
+
+// GetFileAt reads path at the given commit with git show.
+func (s *GitCloneSource) GetFileAt(
+	ctx context.Context,
+	repo *myawesomelistv1.Repository,
+	path, sha string,
+) ([]byte, error) {
+	return s.git(ctx, repo, "show", sha+":"+path)
+}
+
+// BackfillAllCollectionHistory records when each entry of the default collections first and last
+// appeared in their README, walking the README history from src, or from the commits API when src
+// is nil. The walk resumes after the last recorded revision, so the job can be interrupted and
+// later runs only process new revisions.
+func (c *Client) BackfillAllCollectionHistory(ctx context.Context, src CommitSource) error {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.BackfillAllCollectionHistory")
+	defer span.End()
+	if src == nil {
+		src = c
+	}
+	for _, rc := range DefaultGitHubRepos {
+		if err := c.BackfillCollectionHistory(ctx, src, rc.Repo, rc.Options...); err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				span.RecordError(ctxErr)
+				span.SetStatus(codes.Error, ctxErr.Error())
+				return ctxErr
+			}
+			slog.WarnContext(ctx, "Failed to backfill collection history", "hostname", rc.Repo.Hostname, "owner", rc.Repo.Owner, "repo", rc.Repo.Repo, "error", err)
+		}
+	}
+	return nil
+}
+
+// BackfillCollectionHistory walks the README history of an ingested collection from src, parsing
+// each revision with the collection options and recording the first and last revision listing
+// each repository. Revisions which fail to parse are skipped.
+func (c *Client) BackfillCollectionHistory(
+	ctx context.Context,
+	src CommitSource,
+	repo *myawesomelistv1.Repository,
+	opts ...GetCollectionOption,
+) error {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.BackfillCollectionHistory")
+	span.SetAttributes(attribute.String("owner", repo.Owner), attribute.String("repo", repo.Repo))
+	defer span.End()
+	options := &getCollectionOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	colID, err := c.d.GetCollectionID(ctx, repo)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	if colID == 0 {
+		slog.InfoContext(ctx, "Collection not ingested yet; skip history backfill", "hostname", repo.Hostname, "owner", repo.Owner, "repo", repo.Repo)
+		return nil
+	}
+	cursor, err := c.d.GetCollectionHistoryCursor(ctx, colID)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	var since time.Time
+	if cursor != nil {
+		since = cursor.CommittedAt
+	}
+	commits, err := src.ListFileCommits(ctx, repo, collectionReadmePath, since)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return err
+	}
+	if cursor != nil {
+		// Commits sharing the cursor timestamp are listed again; skip up to the cursor itself.
+		if i := slices.IndexFunc(commits, func(fc FileCommit) bool { return fc.SHA == cursor.SHA }); i >= 0 {
+			commits = commits[i+1:]
+		}
+	}
+	span.SetAttributes(attribute.Int("commits", len(commits)))
+	slog.InfoContext(ctx, "Backfilling collection history", "hostname", repo.Hostname, "owner", repo.Owner, "repo", repo.Repo, "commits", len(commits))
+	var skipped int
+	for i, fc := range commits {
+		content, err := src.GetFileAt(ctx, repo, collectionReadmePath, fc.SHA)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+		if encCol, err := encoding.UnmarshallCollection(content, options.eopts...); err != nil {
+			skipped++
+			slog.DebugContext(ctx, "Failed to parse collection revision", "owner", repo.Owner, "repo", repo.Repo, "sha", fc.SHA, "error", err)
+		} else {
+			var repos []*myawesomelistv1.Repository
+			for _, cat := range encCol.ToProto(repo).Categories {
+				for _, p := range cat.Projects {
+					if p.Repo != nil {
+						repos = append(repos, p.Repo)
+					}
+				}
+			}
+			if err := c.d.RecordCollectionEntriesSeen(ctx, database.RecordCollectionEntriesSeenArgs{
+				CollectionID: colID,
+				SHA:          fc.SHA,
+				CommittedAt:  fc.CommittedAt,
+				Repos:        repos,
+			}); err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				return err
+			}
+		}
+		if err := c.d.UpsertCollectionHistoryCursor(ctx, database.UpsertCollectionHistoryCursorArgs{
+			CollectionID: colID,
+			SHA:          fc.SHA,
+			CommittedAt:  fc.CommittedAt,
+		}); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+		if (i+1)%100 == 0 {
+			slog.InfoContext(ctx, "Backfilled collection revisions", "owner", repo.Owner, "repo", repo.Repo, "done", i+1, "total", len(commits))
+		}
+	}
+	span.SetAttributes(attribute.Int("skipped", skipped))
+	slog.InfoContext(ctx, "Backfilled collection history", "hostname", repo.Hostname, "owner", repo.Owner, "repo", repo.Repo, "commits", len(commits), "skipped", skipped)
+	return nil
+}
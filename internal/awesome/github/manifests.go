@@ -0,0 +1,182 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"myawesomelist.shikanime.studio/internal/database"
+	"myawesomelist.shikanime.studio/internal/encoding"
+	myawesomelistv1 "myawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1"
+)
+
+// manifestKind describes where a dependency manifest lives and how to parse it.
+type manifestKind struct {
+	ecosystem string
+	path      string
+	languages []string
+	unmarshal func([]byte) (*encoding.Manifest, error)
+}
+
+var manifestKinds = []manifestKind{
+	{encoding.EcosystemGo, "go.mod", []string{"Go"}, encoding.UnmarshallGoMod},
+	{encoding.EcosystemNpm, "package.json", []string{"JavaScript", "TypeScript"}, encoding.UnmarshallPackageJSON},
+	{encoding.EcosystemHex, "mix.exs", []string{"Elixir"}, encoding.UnmarshallMixExs},
+}
+
+// getFile retrieves the decoded content of a file at the root of the default branch.
+// It returns nil content when the file does not exist.
+func (c *Client) getFile(
+	ctx context.Context,
+	repo *myawesomelistv1.Repository,
+	path string,
+) ([]byte, error) {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.getFile")
+	span.SetAttributes(
+		attribute.String("owner", repo.Owner),
+		attribute.String("repo", repo.Repo),
+		attribute.String("path", path),
+	)
+	defer span.End()
+	if err := c.l.Wait(ctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("rate limiter wait failed: %w", err)
+	}
+	file, _, resp, err := c.c.Repositories.GetContents(ctx, repo.Owner, repo.Repo, path, nil)
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("failed to get %s for %s/%s: %w", path, repo.Owner, repo.Repo, err)
+	}
+	if file == nil {
+		// A directory listing, not a file.
+		return nil, nil
+	}
+	content, err := file.GetContent()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(content), nil
+}
+
+// UpsertAllStaledProjectManifests fetches and parses the go.mod, package.json and mix.exs manifests
+// of listed GitHub projects written in a matching language whose stored manifest is missing or older
+// than ttl (negative disables the TTL), then rebuilds the dependency graph between catalogued projects.
+// Repositories without a parsable manifest are stored without a package so they are not fetched again
+// before ttl. Failures on a single repository are logged and skipped.
+func (c *Client) UpsertAllStaledProjectManifests(ctx context.Context, ttl time.Duration) error {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.UpsertAllStaledProjectManifests")
+	defer span.End()
+	for _, k := range manifestKinds {
+		if err := c.upsertStaledProjectManifests(ctx, k, ttl); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+	}
+	if err := c.d.RefreshProjectDependencies(ctx); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("failed to refresh project dependencies: %w", err)
+	}
+	return nil
+}
+
+func (c *Client) upsertStaledProjectManifests(ctx context.Context, k manifestKind, ttl time.Duration) error {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.upsertStaledProjectManifests")
+	span.SetAttributes(attribute.String("ecosystem", k.ecosystem))
+	defer span.End()
+	staled, err := c.d.ListStaledProjectManifests(ctx, database.ListStaledProjectManifestsArgs{
+		Ecosystem: k.ecosystem,
+		Languages: k.languages,
+		TTL:       ttl,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("failed to list staled %s manifests: %w", k.ecosystem, err)
+	}
+	span.SetAttributes(attribute.Int("staled", len(staled)))
+	slog.InfoContext(ctx, "Fetching project manifests", "ecosystem", k.ecosystem, "count", len(staled))
+	var failed int
+	for _, r := range staled {
+		if r.Hostname != "github.com" {
+			continue
+		}
+		repo := &myawesomelistv1.Repository{Hostname: r.Hostname, Owner: r.Owner, Repo: r.Repo}
+		content, err := c.getFile(ctx, repo, k.path)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			failed++
+			slog.WarnContext(ctx, "Failed to fetch project manifest", "ecosystem", k.ecosystem, "hostname", r.Hostname, "owner", r.Owner, "repo", r.Repo, "error", err)
+			continue
+		}
+		args := database.UpsertProjectManifestArgs{RepositoryID: r.RepositoryID, Ecosystem: k.ecosystem}
+		if content != nil {
+			m, err := k.unmarshal(content)
+			if err != nil {
+				slog.DebugContext(ctx, "Failed to parse project manifest", "ecosystem", k.ecosystem, "owner", r.Owner, "repo", r.Repo, "error", err)
+			} else {
+				args.Package = m.Package
+				args.Dependencies = m.Dependencies
+			}
+		}
+		if err := c.d.UpsertProjectManifest(ctx, args); err != nil {
+			failed++
+			slog.WarnContext(ctx, "Failed to upsert project manifest", "ecosystem", k.ecosystem, "hostname", r.Hostname, "owner", r.Owner, "repo", r.Repo, "error", err)
+		}
+	}
+	span.SetAttributes(attribute.Int("failed", failed))
+	slog.InfoContext(ctx, "Fetched project manifests", "ecosystem", k.ecosystem, "count", len(staled), "failed", failed)
+	return nil
+}
+
+// ListDependencies lists the catalogued projects a repository depends on.
+func (c *Client) ListDependencies(
+	ctx context.Context,
+	repo *myawesomelistv1.Repository,
+) ([]*myawesomelistv1.DependencyEdge, error) {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.ListDependencies")
+	span.SetAttributes(attribute.String("owner", repo.Owner), attribute.String("repo", repo.Repo))
+	defer span.End()
+	edges, err := c.d.ListProjectDependencies(ctx, repo)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("failed to list dependencies of %s/%s: %w", repo.Owner, repo.Repo, err)
+	}
+	return edges, nil
+}
+
+// ListDependents lists the catalogued projects depending on a repository.
+func (c *Client) ListDependents(
+	ctx context.Context,
+	repo *myawesomelistv1.Repository,
+) ([]*myawesomelistv1.DependencyEdge, error) {
+	tracer := otel.Tracer("myawesomelist/github")
+	ctx, span := tracer.Start(ctx, "GitHub.ListDependents")
+	span.SetAttributes(attribute.String("owner", repo.Owner), attribute.String("repo", repo.Repo))
+	defer span.End()
+	edges, err := c.d.ListProjectDependents(ctx, repo)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("failed to list dependents of %s/%s: %w", repo.Owner, repo.Repo, err)
+	}
+	return edges, nil
+}
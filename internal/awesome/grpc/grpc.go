@@ -4,13 +4,16 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"time"
 
 	"connectrpc.com/connect"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
+	"k8s.io/utils/ptr"
 	"myawesomelist.shikanime.studio/internal/awesome"
 	"myawesomelist.shikanime.studio/internal/awesome/github"
+	"myawesomelist.shikanime.studio/internal/database"
 	myawesomelistv1 "myawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1"
 	myawesomelistv1connect "myawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1/myawesomelistv1connect"
 )
@@ -22,6 +25,35 @@ type AwesomeService struct {
 	clients *awesome.Awesome
 }
 
+const (
+	// defaultPageSize is the page size of listings when the request leaves it unset.
+	defaultPageSize = 100
+	// defaultSearchPageSize is the page size of searches when the request leaves it unset.
+	defaultSearchPageSize = 20
+	// defaultSimilarProjectsLimit is the number of similar projects when the request leaves it unset.
+	defaultSimilarProjectsLimit = 10
+	// defaultTrendingProjectsLimit is the number of trending projects when the request leaves it unset.
+	defaultTrendingProjectsLimit = 20
+	// maxPageSize caps the page size of every listing.
+	maxPageSize = 1000
+)
+
+// pageSize returns the requested page size capped to maxPageSize, or def when unset.
+func pageSize(requested, def uint32) uint32 {
+	if requested == 0 {
+		return def
+	}
+	return min(requested, maxPageSize)
+}
+
+// pageError maps a paginated listing error to a connect error.
+func pageError(err error) error {
+	if errors.Is(err, database.ErrInvalidPageToken) {
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return connect.NewError(connect.CodeInternal, err)
+}
+
 // NewAwesomeService constructs an AwesomeService with the given clients.
 func NewAwesomeService(clients *awesome.Awesome) *AwesomeService {
 	return &AwesomeService{clients: clients}
@@ -46,15 +78,23 @@ func (s *AwesomeService) ListCollections(
 		}
 	}
 
-	cols, err := s.clients.GitHub().ListCollections(ctx, repos)
+	page, err := s.clients.GitHub().ListCollectionsPage(ctx, database.ListCollectionsPageArgs{
+		Repos:     repos,
+		PageSize:  pageSize(req.Msg.GetPageSize(), defaultPageSize),
+		PageToken: req.Msg.GetPageToken(),
+	})
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return nil, connect.NewError(connect.CodeInternal, err)
+		return nil, pageError(err)
 	}
 
 	return connect.NewResponse(
-		&myawesomelistv1.ListCollectionsResponse{Collections: cols},
+		&myawesomelistv1.ListCollectionsResponse{
+			Collections:   page.Collections,
+			NextPageToken: page.NextPageToken,
+			TotalSize:     page.TotalSize,
+		},
 	), nil
 }
 
@@ -76,9 +116,13 @@ func (s *AwesomeService) GetCollection(
 			connect.NewError(connect.CodeInvalidArgument, errors.New("repo is required")),
 		)
 	}
+	var opts []github.GetCollectionOption
+	if req.Msg.GetIncludeRemoved() {
+		opts = append(opts, github.WithIncludeRemoved())
+	}
 	switch repo.GetHostname() {
 	case "github.com":
-		coll, err := s.clients.GitHub().GetCollection(ctx, repo)
+		coll, err := s.clients.GitHub().GetCollection(ctx, repo, opts...)
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, err.Error())
@@ -95,6 +139,47 @@ func (s *AwesomeService) GetCollection(
 	}
 }
 
+// ListCollectionChanges returns the entries added, removed, moved or edited between collection ingests.
+func (s *AwesomeService) ListCollectionChanges(
+	ctx context.Context,
+	req *connect.Request[myawesomelistv1.ListCollectionChangesRequest],
+) (
+	*connect.Response[myawesomelistv1.ListCollectionChangesResponse],
+	error,
+) {
+	tracer := otel.Tracer("myawesomelist/grpc")
+	ctx, span := tracer.Start(ctx, "AwesomeService.ListCollectionChanges")
+	defer span.End()
+	limit := pageSize(req.Msg.GetLimit(), defaultPageSize)
+	var start, end *time.Time
+	if req.Msg.StartTime != nil {
+		start = ptr.To(req.Msg.GetStartTime().AsTime())
+	}
+	if req.Msg.EndTime != nil {
+		end = ptr.To(req.Msg.GetEndTime().AsTime())
+	}
+	if start != nil && end != nil && !start.Before(*end) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("start_time must be before end_time"),
+		)
+	}
+	events, err := s.clients.GitHub().ListCollectionChanges(ctx, database.ListCollectionChangesArgs{
+		Repo:  req.Msg.GetRepo(),
+		Start: start,
+		End:   end,
+		Limit: limit,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(
+		&myawesomelistv1.ListCollectionChangesResponse{Events: events},
+	), nil
+}
+
 // ListCategories returns categories for the specified repository.
 func (s *AwesomeService) ListCategories(
 	ctx context.Context,
@@ -115,14 +200,22 @@ func (s *AwesomeService) ListCategories(
 	}
 	switch repo.GetHostname() {
 	case "github.com":
-		coll, err := s.clients.GitHub().GetCollection(ctx, repo)
+		page, err := s.clients.GitHub().ListCategories(ctx, database.ListCategoriesPageArgs{
+			Repo:      repo,
+			PageSize:  pageSize(req.Msg.GetPageSize(), defaultPageSize),
+			PageToken: req.Msg.GetPageToken(),
+		})
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, err.Error())
-			return nil, connect.NewError(connect.CodeInternal, err)
+			return nil, pageError(err)
 		}
 		return connect.NewResponse(
-			&myawesomelistv1.ListCategoriesResponse{Categories: coll.Categories},
+			&myawesomelistv1.ListCategoriesResponse{
+				Categories:    page.Categories,
+				NextPageToken: page.NextPageToken,
+				TotalSize:     page.TotalSize,
+			},
 		), nil
 	default:
 		return nil, connect.NewError(
@@ -152,20 +245,23 @@ func (s *AwesomeService) ListProjects(
 	}
 	switch repo.GetHostname() {
 	case "github.com":
-		coll, err := s.clients.GitHub().GetCollection(ctx, repo)
+		page, err := s.clients.GitHub().ListProjects(ctx, database.ListProjectsPageArgs{
+			Repo:         repo,
+			CategoryName: req.Msg.GetCategoryName(),
+			OrderBy:      req.Msg.GetOrderBy(),
+			PageSize:     pageSize(req.Msg.GetPageSize(), defaultPageSize),
+			PageToken:    req.Msg.GetPageToken(),
+		})
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, err.Error())
-			return nil, connect.NewError(connect.CodeInternal, err)
-		}
-		var projects []*myawesomelistv1.Project
-		for _, c := range coll.Categories {
-			if c.Name == req.Msg.GetCategoryName() {
-				projects = c.Projects
-				break
-			}
+			return nil, pageError(err)
 		}
-		return connect.NewResponse(&myawesomelistv1.ListProjectsResponse{Projects: projects}), nil
+		return connect.NewResponse(&myawesomelistv1.ListProjectsResponse{
+			Projects:      page.Projects,
+			NextPageToken: page.NextPageToken,
+			TotalSize:     page.TotalSize,
+		}), nil
 	default:
 		return nil, connect.NewError(
 			connect.CodeInvalidArgument,
@@ -197,17 +293,70 @@ func (s *AwesomeService) SearchProjects(
 		"repos",
 		len(repos),
 	)
-	projects, err := s.clients.Agent().SearchProjects(ctx, req.Msg)
+	if req.Msg.GetSemanticWeight() < 0 || req.Msg.GetLexicalWeight() < 0 {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("semantic_weight and lexical_weight must not be negative"),
+		)
+	}
+	if req.Msg.MinStars != nil && req.Msg.MaxStars != nil && req.Msg.GetMinStars() > req.Msg.GetMaxStars() {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("min_stars must not be greater than max_stars"),
+		)
+	}
+	if req.Msg.PageSize == 0 {
+		req.Msg.PageSize = limit
+	}
+	req.Msg.PageSize = pageSize(req.Msg.PageSize, defaultSearchPageSize)
+	page, err := s.clients.Agent().SearchProjects(ctx, req.Msg)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
+		return nil, pageError(err)
+	}
+	slog.DebugContext(ctx, "search projects response", "count", len(page.Projects))
+	return connect.NewResponse(&myawesomelistv1.SearchProjectsResponse{
+		Projects:      page.Projects,
+		NextPageToken: page.NextPageToken,
+		TotalSize:     page.TotalSize,
+	}), nil
+}
+
+// FindSimilarProjects returns the projects whose stored embedding is nearest to a project or repository.
+func (s *AwesomeService) FindSimilarProjects(
+	ctx context.Context,
+	req *connect.Request[myawesomelistv1.FindSimilarProjectsRequest],
+) (
+	*connect.Response[myawesomelistv1.FindSimilarProjectsResponse],
+	error,
+) {
+	tracer := otel.Tracer("myawesomelist/grpc")
+	ctx, span := tracer.Start(ctx, "AwesomeService.FindSimilarProjects")
+	defer span.End()
+	if req.Msg.GetProjectId() == 0 && req.Msg.GetRepo() == nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("project_id or repo is required"),
+		)
+	}
+	req.Msg.Limit = pageSize(req.Msg.GetLimit(), defaultSimilarProjectsLimit)
+	projects, err := s.clients.Agent().FindSimilarProjects(ctx, req.Msg)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		if errors.Is(err, database.ErrEmbeddingNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
 		return nil, connect.NewError(connect.CodeInternal, err)
 	}
-	slog.DebugContext(ctx, "search projects response", "count", len(projects))
-	return connect.NewResponse(&myawesomelistv1.SearchProjectsResponse{Projects: projects}), nil
+	slog.DebugContext(ctx, "find similar projects response", "count", len(projects))
+	return connect.NewResponse(
+		&myawesomelistv1.FindSimilarProjectsResponse{Projects: projects},
+	), nil
 }
 
-// GetProjectStats returns per-repo stats (stars, open issues) persisted in datastore.
+// GetProjectStats returns per-repo stats (stars, issues, forks, license, activity, releases, contributors and bus factor) persisted in datastore.
 func (s *AwesomeService) GetProjectStats(
 	ctx context.Context,
 	req *connect.Request[myawesomelistv1.GetProjectStatsRequest],
@@ -242,3 +391,172 @@ func (s *AwesomeService) GetProjectStats(
 		)
 	}
 }
+
+// GetProjectStatsHistory returns the recorded stats time series of a repository.
+func (s *AwesomeService) GetProjectStatsHistory(
+	ctx context.Context,
+	req *connect.Request[myawesomelistv1.GetProjectStatsHistoryRequest],
+) (
+	*connect.Response[myawesomelistv1.GetProjectStatsHistoryResponse],
+	error,
+) {
+	tracer := otel.Tracer("myawesomelist/grpc")
+	ctx, span := tracer.Start(ctx, "AwesomeService.GetProjectStatsHistory")
+	defer span.End()
+	repo := req.Msg.GetRepo()
+	if repo == nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			connect.NewError(connect.CodeInvalidArgument, errors.New("repo is required")),
+		)
+	}
+	var start, end *time.Time
+	if req.Msg.StartTime != nil {
+		start = ptr.To(req.Msg.GetStartTime().AsTime())
+	}
+	if req.Msg.EndTime != nil {
+		end = ptr.To(req.Msg.GetEndTime().AsTime())
+	}
+	if start != nil && end != nil && !start.Before(*end) {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("start_time must be before end_time"),
+		)
+	}
+
+	switch repo.GetHostname() {
+	case "github.com":
+		points, err := s.clients.GitHub().
+			GetProjectStatsHistory(ctx, repo, start, end, req.Msg.GetInterval())
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return connect.NewResponse(
+			&myawesomelistv1.GetProjectStatsHistoryResponse{Points: points},
+		), nil
+	default:
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			connect.NewError(connect.CodeUnimplemented, errors.New("hostname is not supported")),
+		)
+	}
+}
+
+// ListDependencies lists the catalogued projects a repository depends on.
+func (s *AwesomeService) ListDependencies(
+	ctx context.Context,
+	req *connect.Request[myawesomelistv1.ListDependenciesRequest],
+) (
+	*connect.Response[myawesomelistv1.ListDependenciesResponse],
+	error,
+) {
+	tracer := otel.Tracer("myawesomelist/grpc")
+	ctx, span := tracer.Start(ctx, "AwesomeService.ListDependencies")
+	defer span.End()
+	repo := req.Msg.GetRepo()
+	if repo == nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			connect.NewError(connect.CodeInvalidArgument, errors.New("repo is required")),
+		)
+	}
+
+	switch repo.GetHostname() {
+	case "github.com":
+		edges, err := s.clients.GitHub().ListDependencies(ctx, repo)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return connect.NewResponse(
+			&myawesomelistv1.ListDependenciesResponse{Dependencies: edges},
+		), nil
+	default:
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			connect.NewError(connect.CodeUnimplemented, errors.New("hostname is not supported")),
+		)
+	}
+}
+
+// ListDependents lists the catalogued projects depending on a repository.
+func (s *AwesomeService) ListDependents(
+	ctx context.Context,
+	req *connect.Request[myawesomelistv1.ListDependentsRequest],
+) (
+	*connect.Response[myawesomelistv1.ListDependentsResponse],
+	error,
+) {
+	tracer := otel.Tracer("myawesomelist/grpc")
+	ctx, span := tracer.Start(ctx, "AwesomeService.ListDependents")
+	defer span.End()
+	repo := req.Msg.GetRepo()
+	if repo == nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			connect.NewError(connect.CodeInvalidArgument, errors.New("repo is required")),
+		)
+	}
+
+	switch repo.GetHostname() {
+	case "github.com":
+		edges, err := s.clients.GitHub().ListDependents(ctx, repo)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		return connect.NewResponse(
+			&myawesomelistv1.ListDependentsResponse{Dependents: edges},
+		), nil
+	default:
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			connect.NewError(connect.CodeUnimplemented, errors.New("hostname is not supported")),
+		)
+	}
+}
+
+// ListTrendingProjects ranks projects by star gain over a window, optionally scoped to a collection, category or language.
+func (s *AwesomeService) ListTrendingProjects(
+	ctx context.Context,
+	req *connect.Request[myawesomelistv1.ListTrendingProjectsRequest],
+) (
+	*connect.Response[myawesomelistv1.ListTrendingProjectsResponse],
+	error,
+) {
+	tracer := otel.Tracer("myawesomelist/grpc")
+	ctx, span := tracer.Start(ctx, "AwesomeService.ListTrendingProjects")
+	defer span.End()
+	limit := pageSize(req.Msg.GetLimit(), defaultTrendingProjectsLimit)
+	slog.DebugContext(
+		ctx,
+		"list trending projects request",
+		"window",
+		req.Msg.GetWindow().String(),
+		"category",
+		req.Msg.GetCategoryName(),
+		"language",
+		req.Msg.GetLanguage(),
+		"limit",
+		limit,
+	)
+	projects, err := s.clients.Database().ListTrendingProjects(ctx, database.ListTrendingProjectsArgs{
+		Window:       req.Msg.GetWindow(),
+		Repo:         req.Msg.GetRepo(),
+		CategoryName: req.Msg.GetCategoryName(),
+		Language:     req.Msg.GetLanguage(),
+		Limit:        limit,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	return connect.NewResponse(
+		&myawesomelistv1.ListTrendingProjectsResponse{Projects: projects},
+	), nil
+}
@@ -0,0 +1,162 @@
+// Package health computes a composite health score for listed repositories.
+//
+// The score ranges from 0 to 100 and is the weighted mean of the following
+// components, each normalized between 0 and 1. Components whose signal is
+// unknown are left out and the remaining weights are rescaled.
+//
+//   - activity (30%): 1 when the last push is at most 30 days old, decreasing
+//     linearly to 0 at one year.
+//   - releases (20%): recency of the latest release, 1 up to 90 days and 0 at
+//     two years, blended 70/30 with the release cadence, 1 up to one release
+//     every 30 days and 0 at one release a year.
+//   - issues (15%): 1 without open issues, decreasing linearly to 0 when open
+//     issues reach 10% of the stargazers.
+//   - popularity (20%): log10 of the stargazers, reaching 1 at 100k stars.
+//   - contributors (15%): log10 of the contributors, reaching 1 at 50.
+//
+// Archived repositories are capped at 20 and repositories that can no longer
+// be fetched (not found, blocked or disabled) score 0.
+package health
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"myawesomelist.shikanime.studio/internal/database"
+	myawesomelistv1 "myawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1"
+)
+
+const (
+	activityWeight     = 0.30
+	releasesWeight     = 0.20
+	issuesWeight       = 0.15
+	popularityWeight   = 0.20
+	contributorsWeight = 0.15
+
+	// archivedCap is the highest score an archived repository can get.
+	archivedCap = 20
+)
+
+// Signals holds the repository signals the health score is computed from.
+// Nil fields are unknown and left out of the score.
+type Signals struct {
+	StargazersCount    *uint32
+	OpenIssueCount     *uint32
+	ContributorsCount  *uint32
+	PushedAt           *time.Time
+	ReleasePublishedAt *time.Time
+	ReleaseCadenceDays *float64
+	Archived           bool
+	Status             myawesomelistv1.RepositoryStatus
+}
+
+// Score computes the health score of a repository at the given time.
+func Score(s Signals, now time.Time) float64 {
+	switch s.Status {
+	case myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_NOT_FOUND,
+		myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_BLOCKED,
+		myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_DISABLED:
+		return 0
+	}
+	var sum, weights float64
+	add := func(weight, value float64) {
+		sum += weight * clamp(value)
+		weights += weight
+	}
+	if s.PushedAt != nil {
+		add(activityWeight, decay(now.Sub(*s.PushedAt), 30*day, 365*day))
+	}
+	if s.ReleasePublishedAt != nil {
+		v := decay(now.Sub(*s.ReleasePublishedAt), 90*day, 730*day)
+		if s.ReleaseCadenceDays != nil {
+			cadence := decay(time.Duration(*s.ReleaseCadenceDays*float64(day)), 30*day, 365*day)
+			v = 0.7*v + 0.3*cadence
+		}
+		add(releasesWeight, v)
+	}
+	if s.StargazersCount != nil {
+		stars := float64(*s.StargazersCount)
+		add(popularityWeight, math.Log10(stars+1)/5)
+		if s.OpenIssueCount != nil {
+			add(issuesWeight, 1-float64(*s.OpenIssueCount)/(0.1*(stars+1)))
+		}
+	}
+	if s.ContributorsCount != nil {
+		add(contributorsWeight, math.Log10(float64(*s.ContributorsCount)+1)/math.Log10(51))
+	}
+	if weights == 0 {
+		return 0
+	}
+	score := 100 * sum / weights
+	if s.Archived || s.Status == myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_ARCHIVED {
+		score = math.Min(score, archivedCap)
+	}
+	return math.Round(score*100) / 100
+}
+
+const day = 24 * time.Hour
+
+// decay returns 1 up to full, then decreases linearly to 0 at zero.
+func decay(age, full, zero time.Duration) float64 {
+	if age <= full {
+		return 1
+	}
+	return 1 - float64(age-full)/float64(zero-full)
+}
+
+func clamp(v float64) float64 { return math.Max(0, math.Min(1, v)) }
+
+// Client computes and stores repository health scores.
+type Client struct {
+	d *database.Database
+}
+
+// NewClient constructs a health Client with the given datastore.
+func NewClient(db *database.Database) *Client {
+	return &Client{d: db}
+}
+
+// UpdateAllStaledHealthScores recomputes the health score of every repository whose score is missing,
+// older than its stats or release, or older than ttl (negative disables the TTL).
+func (c *Client) UpdateAllStaledHealthScores(ctx context.Context, ttl time.Duration) error {
+	tracer := otel.Tracer("myawesomelist/health")
+	ctx, span := tracer.Start(ctx, "Health.UpdateAllStaledHealthScores")
+	defer span.End()
+	staled, err := c.d.ListStaledProjectHealth(ctx, database.ListStaledProjectHealthArgs{TTL: ttl})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("failed to list staled project health: %w", err)
+	}
+	span.SetAttributes(attribute.Int("staled", len(staled)))
+	slog.InfoContext(ctx, "Computing project health scores", "count", len(staled))
+	now := time.Now()
+	args := make([]database.UpdateProjectHealthScoreArgs, 0, len(staled))
+	for _, p := range staled {
+		args = append(args, database.UpdateProjectHealthScoreArgs{
+			RepositoryID: p.RepositoryID,
+			Score: Score(Signals{
+				StargazersCount:    p.StargazersCount,
+				OpenIssueCount:     p.OpenIssueCount,
+				PushedAt:           p.PushedAt,
+				ReleasePublishedAt: p.ReleasePublishedAt,
+				ReleaseCadenceDays: p.ReleaseCadenceDays,
+				ContributorsCount:  p.ContributorsCount,
+				Archived:           p.Archived,
+				Status:             database.RepositoryStatusFromString(p.Status),
+			}, now),
+		})
+	}
+	if err := c.d.UpdateProjectHealthScores(ctx, args); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("failed to update project health scores: %w", err)
+	}
+	return nil
+}
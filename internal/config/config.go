@@ -58,6 +58,21 @@ func (c *Config) Bind() error {
 	if err := c.v.BindEnv("project_stats_ttl", "PROJECT_STATS_TTL"); err != nil {
 		return err
 	}
+	if err := c.v.BindEnv("negative_cache_ttl", "NEGATIVE_CACHE_TTL"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("project_readme_ttl", "PROJECT_README_TTL"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("registry_stats_ttl", "REGISTRY_STATS_TTL"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("project_manifests_ttl", "PROJECT_MANIFESTS_TTL"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("project_health_ttl", "PROJECT_HEALTH_TTL"); err != nil {
+		return err
+	}
 	if err := c.v.BindEnv("project_embeddings_ttl", "PROJECT_EMBEDDINGS_TTL"); err != nil {
 		return err
 	}
@@ -70,6 +85,60 @@ func (c *Config) Bind() error {
 	if err := c.v.BindEnv("embedding_model", "EMBEDDING_MODEL"); err != nil {
 		return err
 	}
+	if err := c.v.BindEnv("embedding_backend", "EMBEDDING_BACKEND"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("embedding_base_url", "EMBEDDING_BASE_URL"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("embedding_dimensions", "EMBEDDING_DIMENSIONS"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("embedding_batch_tokens", "EMBEDDING_BATCH_TOKENS"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("embedding_max_input_tokens", "EMBEDDING_MAX_INPUT_TOKENS"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("embedding_document_template", "EMBEDDING_DOCUMENT_TEMPLATE"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("embedding_readme_excerpt_len", "EMBEDDING_README_EXCERPT_LEN"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("query_embedding_cache_size", "QUERY_EMBEDDING_CACHE_SIZE"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("query_embedding_cache_persist", "QUERY_EMBEDDING_CACHE_PERSIST"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("query_embedding_cache_ttl", "QUERY_EMBEDDING_CACHE_TTL"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("embedding_distance", "EMBEDDING_DISTANCE"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("vector_index", "VECTOR_INDEX"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("hnsw_m", "HNSW_M"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("hnsw_ef_construction", "HNSW_EF_CONSTRUCTION"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("hnsw_ef_search", "HNSW_EF_SEARCH"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("ivfflat_lists", "IVFFLAT_LISTS"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("ivfflat_probes", "IVFFLAT_PROBES"); err != nil {
+		return err
+	}
+	if err := c.v.BindEnv("page_token_secret", "PAGE_TOKEN_SECRET"); err != nil {
+		return err
+	}
 	if err := c.v.BindEnv("log_level", "LOG_LEVEL"); err != nil {
 		return err
 	}
@@ -189,6 +258,66 @@ func (c *Config) GetProjectStatsTTL() time.Duration {
 	return def
 }
 
+// GetNegativeCacheTTL returns how long unavailable repositories are cached before being checked again.
+// Reads duration from env var NEGATIVE_CACHE_TTL; defaults to 72h.
+func (c *Config) GetNegativeCacheTTL() time.Duration {
+	const def = 72 * time.Hour
+	if v := c.v.GetString("negative_cache_ttl"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return def
+}
+
+// GetProjectReadmeTTL returns how long a listed project README is kept before being fetched again.
+// Reads duration from env var PROJECT_README_TTL; defaults to 168h.
+func (c *Config) GetProjectReadmeTTL() time.Duration {
+	const def = 7 * 24 * time.Hour
+	if v := c.v.GetString("project_readme_ttl"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return def
+}
+
+// GetRegistryStatsTTL returns how long package registry stats are kept before being fetched again.
+// Reads duration from env var REGISTRY_STATS_TTL; defaults to 24h.
+func (c *Config) GetRegistryStatsTTL() time.Duration {
+	const def = 24 * time.Hour
+	if v := c.v.GetString("registry_stats_ttl"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return def
+}
+
+// GetProjectManifestsTTL returns how long parsed dependency manifests are kept before being fetched again.
+// Reads duration from env var PROJECT_MANIFESTS_TTL; defaults to 168h.
+func (c *Config) GetProjectManifestsTTL() time.Duration {
+	const def = 168 * time.Hour
+	if v := c.v.GetString("project_manifests_ttl"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return def
+}
+
+// GetProjectHealthTTL returns how long a health score is kept before being recomputed.
+// Reads duration from env var PROJECT_HEALTH_TTL; defaults to 24h.
+func (c *Config) GetProjectHealthTTL() time.Duration {
+	const def = 24 * time.Hour
+	if v := c.v.GetString("project_health_ttl"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return def
+}
+
 func (c *Config) GetProjectEmbeddingsTTL() time.Duration {
 	if v := c.v.GetString("project_embeddings_ttl"); v != "" {
 		if d, err := time.ParseDuration(v); err == nil {
@@ -207,8 +336,131 @@ func (c *Config) GetOpenAIAPIKey() string { return c.v.GetString("openai_api_key
 
 // GetEmbeddingModel returns the OpenAI embedding model from env var EMBEDDING_MODEL.
 func (c *Config) GetEmbeddingModel() string { return c.v.GetString("embedding_model") }
+
+// GetEmbeddingBackend returns the embeddings backend from env var EMBEDDING_BACKEND.
+// Recognized values: openai (default), ollama, tei and hash.
+func (c *Config) GetEmbeddingBackend() string {
+	if v := strings.ToLower(c.v.GetString("embedding_backend")); v != "" {
+		return v
+	}
+	return "openai"
+}
+
+// GetEmbeddingBaseURL returns the base URL of the ollama and tei embeddings backends from env var
+// EMBEDDING_BASE_URL.
+func (c *Config) GetEmbeddingBaseURL() string { return c.v.GetString("embedding_base_url") }
+
+// GetEmbeddingDimensions returns the dimensions of the hash embeddings backend from env var
+// EMBEDDING_DIMENSIONS; defaults to 3584.
+func (c *Config) GetEmbeddingDimensions() int {
+	if v := c.v.GetInt("embedding_dimensions"); v > 0 {
+		return v
+	}
+	return 3584
+}
+
+// GetEmbeddingBatchTokens returns the estimated token budget of a single embeddings request
+// from env var EMBEDDING_BATCH_TOKENS; defaults to 16384.
+func (c *Config) GetEmbeddingBatchTokens() int {
+	if v := c.v.GetInt("embedding_batch_tokens"); v > 0 {
+		return v
+	}
+	return 16384
+}
+
+// GetEmbeddingMaxInputTokens returns the estimated token limit of a single embeddings input from
+// env var EMBEDDING_MAX_INPUT_TOKENS; defaults to 8000.
+func (c *Config) GetEmbeddingMaxInputTokens() int {
+	if v := c.v.GetInt("embedding_max_input_tokens"); v > 0 {
+		return v
+	}
+	return 8000
+}
+
+// GetEmbeddingDocumentTemplate returns the text/template rendering the text embedded for a
+// project from env var EMBEDDING_DOCUMENT_TEMPLATE; empty keeps the default template.
+func (c *Config) GetEmbeddingDocumentTemplate() string {
+	return c.v.GetString("embedding_document_template")
+}
+
+// GetEmbeddingReadmeExcerptLen returns the length up to which README paragraphs are embedded
+// from env var EMBEDDING_README_EXCERPT_LEN; defaults to 1000.
+func (c *Config) GetEmbeddingReadmeExcerptLen() int {
+	if v := c.v.GetInt("embedding_readme_excerpt_len"); v > 0 {
+		return v
+	}
+	return 1000
+}
+
+// GetQueryEmbeddingCacheSize returns the number of query embeddings kept in memory from env var
+// QUERY_EMBEDDING_CACHE_SIZE; defaults to 1024.
+func (c *Config) GetQueryEmbeddingCacheSize() int {
+	if v := c.v.GetInt("query_embedding_cache_size"); v > 0 {
+		return v
+	}
+	return 1024
+}
+
+// GetQueryEmbeddingCachePersist reports whether query embeddings are persisted in the database
+// from env var QUERY_EMBEDDING_CACHE_PERSIST.
+func (c *Config) GetQueryEmbeddingCachePersist() bool {
+	return c.v.GetBool("query_embedding_cache_persist")
+}
+
+// GetQueryEmbeddingCacheTTL returns how long a persisted query embedding is kept unused from env
+// var QUERY_EMBEDDING_CACHE_TTL; defaults to 720h.
+func (c *Config) GetQueryEmbeddingCacheTTL() time.Duration {
+	const def = 720 * time.Hour
+	if v := c.v.GetString("query_embedding_cache_ttl"); v != "" {
+		if d, err := time.ParseDuration(v); err == nil {
+			return d
+		}
+	}
+	return def
+}
+
+// GetEmbeddingDistance returns the metric comparing embeddings from env var EMBEDDING_DISTANCE.
+// Recognized values: l2 (default), cosine and inner_product.
+func (c *Config) GetEmbeddingDistance() string { return c.v.GetString("embedding_distance") }
+
+// GetVectorIndex returns the embedding index method from env var VECTOR_INDEX.
+// Recognized values: hnsw (default), ivfflat and none.
+func (c *Config) GetVectorIndex() string { return c.v.GetString("vector_index") }
+
+// GetHNSWM returns the maximum connections per HNSW layer from env var HNSW_M; defaults to 16.
+func (c *Config) GetHNSWM() int {
+	if v := c.v.GetInt("hnsw_m"); v > 0 {
+		return v
+	}
+	return 16
+}
+
+// GetHNSWEfConstruction returns the HNSW build candidate list size from env var
+// HNSW_EF_CONSTRUCTION; defaults to 64.
+func (c *Config) GetHNSWEfConstruction() int {
+	if v := c.v.GetInt("hnsw_ef_construction"); v > 0 {
+		return v
+	}
+	return 64
+}
+
+// GetHNSWEfSearch returns the HNSW search candidate list size from env var HNSW_EF_SEARCH;
+// 0 keeps the server default. Searches raise it to the number of candidates they rank.
+func (c *Config) GetHNSWEfSearch() int { return c.v.GetInt("hnsw_ef_search") }
+
+// GetIVFFlatLists returns the IVFFlat list count from env var IVFFLAT_LISTS; 0 derives it from
+// the number of embeddings.
+func (c *Config) GetIVFFlatLists() int { return c.v.GetInt("ivfflat_lists") }
+
+// GetIVFFlatProbes returns the IVFFlat lists probed per search from env var IVFFLAT_PROBES;
+// 0 keeps the server default.
+func (c *Config) GetIVFFlatProbes() int { return c.v.GetInt("ivfflat_probes") }
+
 func (c *Config) Set(key string, value any) { c.v.Set(key, value) }
 
+// GetPageTokenSecret returns the key signing page tokens from env var PAGE_TOKEN_SECRET.
+func (c *Config) GetPageTokenSecret() string { return c.v.GetString("page_token_secret") }
+
 // GetLogLevel returns the log level from env var LOG_LEVEL mapped to slog.Level.
 // Recognized values: debug, info (default), warn|warning, error.
 func (c *Config) GetLogLevel() slog.Level {
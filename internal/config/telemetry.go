@@ -4,13 +4,17 @@ import (
 	"context"
 
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/propagation"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
 )
 
+// SetupTelemetry installs the global tracer and meter providers, exporting traces and metrics
+// through OTLP over HTTP as configured by the OTEL_EXPORTER_OTLP_* environment variables.
 func SetupTelemetry(ctx context.Context, cfg *Config) (func(), error) {
 	if err := cfg.Bind(); err != nil {
 		return func() {}, err
@@ -30,14 +34,26 @@ func SetupTelemetry(ctx context.Context, cfg *Config) (func(), error) {
 	if rerr != nil {
 		return func() {}, rerr
 	}
+	mexp, err := otlpmetrichttp.New(ctx)
+	if err != nil {
+		return func() {}, err
+	}
 	tp := sdktrace.NewTracerProvider(
 		sdktrace.WithBatcher(exp),
 		sdktrace.WithResource(res),
 	)
 	otel.SetTracerProvider(tp)
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(mexp)),
+		sdkmetric.WithResource(res),
+	)
+	otel.SetMeterProvider(mp)
 	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
 		propagation.TraceContext{},
 		propagation.Baggage{},
 	))
-	return func() { _ = tp.Shutdown(ctx) }, nil
+	return func() {
+		_ = tp.Shutdown(ctx)
+		_ = mp.Shutdown(ctx)
+	}, nil
 }
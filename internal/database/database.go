@@ -5,6 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -14,6 +18,7 @@ import (
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/codes"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"k8s.io/utils/ptr"
 	"myawesomelist.shikanime.studio/internal/config"
 	dbpgx "myawesomelist.shikanime.studio/internal/database/pgx"
 	myawesomelistv1 "myawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1"
@@ -24,16 +29,22 @@ type Repository struct {
 	Hostname string
 	Owner    string
 	Repo     string
+	Status   string
 }
 
 type Project struct {
-	ID           uint64
-	CategoryID   uint64
-	RepositoryID uint64
-	Repository   Repository
-	Name         string
-	Description  string
-	UpdatedAt    time.Time
+	ID              uint64
+	CategoryID      uint64
+	RepositoryID    uint64
+	Repository      Repository
+	Name            string
+	Description     string
+	UpdatedAt       time.Time
+	Release         ProjectRelease
+	HealthScore     *float64
+	DependentsCount uint32
+	RemovedAt       *time.Time
+	FirstSeenAt     time.Time
 }
 
 type Category struct {
@@ -42,6 +53,7 @@ type Category struct {
 	Name         string
 	Projects     []Project
 	UpdatedAt    time.Time
+	RemovedAt    *time.Time
 }
 
 type Collection struct {
@@ -54,29 +66,240 @@ type Collection struct {
 }
 
 type ProjectStats struct {
-	ID              uint64
-	RepositoryID    uint64
-	StargazersCount *uint32
-	OpenIssueCount  *uint32
-	UpdatedAt       time.Time
+	ID                  uint64
+	RepositoryID        uint64
+	StargazersCount     *uint32
+	OpenIssueCount      *uint32
+	UpdatedAt           time.Time
+	Status              string
+	StatusCheckedAt     *time.Time
+	ForksCount          *uint32
+	SubscribersCount    *uint32
+	License             *string
+	Topics              []string
+	Language            *string
+	DefaultBranch       *string
+	CreatedAt           *time.Time
+	PushedAt            *time.Time
+	Archived            bool
+	Release             ProjectRelease
+	CadenceDays         *float64
+	HealthScore         *float64
+	ContributorsCount   *uint32
+	TopContributorShare *float64
+	BusFactor           *uint32
+}
+
+// ProjectRelease holds the latest release columns of a repository, all nullable when none was recorded.
+type ProjectRelease struct {
+	TagName     *string
+	Name        *string
+	PublishedAt *time.Time
+	Prerelease  *bool
+	URL         *string
+}
+
+// Proto converts the release into its protobuf message, or nil when the repository has no release.
+func (r ProjectRelease) Proto() *myawesomelistv1.Release {
+	if r.TagName == nil {
+		return nil
+	}
+	rel := &myawesomelistv1.Release{
+		TagName:    *r.TagName,
+		Name:       ptr.Deref(r.Name, ""),
+		Prerelease: ptr.Deref(r.Prerelease, false),
+		Url:        ptr.Deref(r.URL, ""),
+	}
+	if r.PublishedAt != nil {
+		rel.PublishedAt = timestamppb.New(*r.PublishedAt)
+	}
+	return rel
+}
+
+// Proto converts the project to its protobuf representation; a zero FirstSeenAt is left unset.
+func (p Project) Proto() *myawesomelistv1.Project {
+	var firstSeenAt *time.Time
+	if !p.FirstSeenAt.IsZero() {
+		firstSeenAt = &p.FirstSeenAt
+	}
+	return &myawesomelistv1.Project{
+		Id:          p.ID,
+		Name:        p.Name,
+		Description: p.Description,
+		Repo: &myawesomelistv1.Repository{
+			Hostname: p.Repository.Hostname,
+			Owner:    p.Repository.Owner,
+			Repo:     p.Repository.Repo,
+		},
+		UpdatedAt:       timestamppb.New(p.UpdatedAt),
+		Status:          RepositoryStatusFromString(p.Repository.Status),
+		LatestRelease:   p.Release.Proto(),
+		HealthScore:     p.HealthScore,
+		DependentsCount: p.DependentsCount,
+		RemovedAt:       timestamppbOrNil(p.RemovedAt),
+		FirstSeenAt:     timestamppbOrNil(firstSeenAt),
+	}
+}
+
+// scanProject scans a row of ProjectsByCategoryIDsQuery.
+func scanProject(row pgx.CollectableRow) (Project, error) {
+	return scanProjectWith(row)
+}
+
+// scanProjectWith scans a row of the columns of ProjectsByCategoryIDsQuery followed by the
+// columns scanned into extra.
+func scanProjectWith(row pgx.CollectableRow, extra ...any) (Project, error) {
+	var p Project
+	var h, o, rr, st string
+	err := row.Scan(append([]any{
+		&p.ID,
+		&p.CategoryID,
+		&p.RepositoryID,
+		&p.Name,
+		&p.Description,
+		&p.UpdatedAt,
+		&h,
+		&o,
+		&rr,
+		&st,
+		&p.Release.TagName,
+		&p.Release.Name,
+		&p.Release.PublishedAt,
+		&p.Release.Prerelease,
+		&p.Release.URL,
+		&p.HealthScore,
+		&p.DependentsCount,
+		&p.RemovedAt,
+		&p.FirstSeenAt,
+	}, extra...)...)
+	p.Repository = Repository{ID: p.RepositoryID, Hostname: h, Owner: o, Repo: rr, Status: st}
+	return p, err
+}
+
+// timestamppbOrNil converts an optional time into a timestamp, keeping nil as nil.
+func timestamppbOrNil(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// repositoryStatuses maps repository statuses to their stored representation.
+var repositoryStatuses = map[myawesomelistv1.RepositoryStatus]string{
+	myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_ACTIVE:    "active",
+	myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_ARCHIVED:  "archived",
+	myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_DISABLED:  "disabled",
+	myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_NOT_FOUND: "not_found",
+	myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_BLOCKED:   "blocked",
+}
+
+// RepositoryStatusToString returns the stored representation of a repository status.
+// Unspecified statuses are stored as active.
+func RepositoryStatusToString(status myawesomelistv1.RepositoryStatus) string {
+	if s, ok := repositoryStatuses[status]; ok {
+		return s
+	}
+	return "active"
+}
+
+// RepositoryStatusFromString parses a stored repository status.
+func RepositoryStatusFromString(s string) myawesomelistv1.RepositoryStatus {
+	for status, name := range repositoryStatuses {
+		if name == s {
+			return status
+		}
+	}
+	return myawesomelistv1.RepositoryStatus_REPOSITORY_STATUS_UNSPECIFIED
 }
 
+// ErrEmbeddingNotFound is returned when a project has no stored embedding.
+var ErrEmbeddingNotFound = errors.New("project embedding not found")
+
 type Database struct {
-	pg *pgxpool.Pool
+	pg           *pgxpool.Pool
+	tokens       *pageTokens
+	distance     Distance
+	hnswEfSearch int
+}
+
+// DatabaseOptions holds configuration for initializing a Database.
+type DatabaseOptions struct {
+	pageTokenSecret string
+	distance        Distance
+	hnswEfSearch    int
+}
+
+// DatabaseOption applies a configuration to DatabaseOptions.
+type DatabaseOption func(*DatabaseOptions)
+
+// WithPageTokenSecret sets the key signing page tokens. Without it, a random key is generated
+// and page tokens do not survive restarts nor work across replicas.
+func WithPageTokenSecret(secret string) DatabaseOption {
+	return func(o *DatabaseOptions) { o.pageTokenSecret = secret }
+}
+
+// WithEmbeddingDistance sets the metric comparing embeddings, which defaults to DistanceL2.
+// Embedding indexes must be rebuilt when it changes.
+func WithEmbeddingDistance(d Distance) DatabaseOption {
+	return func(o *DatabaseOptions) { o.distance = d }
+}
+
+// WithHNSWEfSearch sets the minimum HNSW search candidate list size of searches, which are
+// raised to the number of candidates they rank.
+func WithHNSWEfSearch(n int) DatabaseOption {
+	return func(o *DatabaseOptions) { o.hnswEfSearch = n }
 }
 
 // NewForConfig constructs a Database using the provided config.
 // It initializes the pgx pool and embeddings internally.
 func NewForConfig(cfg *config.Config) (*Database, error) {
+	distance, err := ParseDistance(cfg.GetEmbeddingDistance())
+	if err != nil {
+		return nil, err
+	}
 	pg, err := dbpgx.NewClientForConfig(cfg)
 	if err != nil {
 		return nil, err
 	}
-	return NewClient(pg), nil
+	return NewClient(
+		pg,
+		WithPageTokenSecret(cfg.GetPageTokenSecret()),
+		WithEmbeddingDistance(distance),
+		WithHNSWEfSearch(cfg.GetHNSWEfSearch()),
+	), nil
 }
 
 // NewClient constructs a Database using the provided pgx pool.
-func NewClient(pg *pgxpool.Pool) *Database { return &Database{pg: pg} }
+func NewClient(pg *pgxpool.Pool, opts ...DatabaseOption) *Database {
+	o := DatabaseOptions{distance: DistanceL2}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.pageTokenSecret == "" {
+		slog.Info("PAGE_TOKEN_SECRET not set; page tokens are only valid until restart")
+	}
+	return &Database{
+		pg:           pg,
+		tokens:       newPageTokens(o.pageTokenSecret),
+		distance:     o.distance,
+		hnswEfSearch: o.hnswEfSearch,
+	}
+}
+
+// maxHNSWEfSearch is the largest HNSW search candidate list size pgvector accepts.
+const maxHNSWEfSearch = 1000
+
+// vectorSearch runs fn in a transaction whose HNSW index scans return at least candidates rows,
+// since rows filtered out after the scan are not replaced otherwise.
+func (db *Database) vectorSearch(ctx context.Context, candidates int, fn func(pgx.Tx) error) error {
+	return pgx.BeginFunc(ctx, db.pg, func(tx pgx.Tx) error {
+		efSearch := min(max(db.hnswEfSearch, candidates), maxHNSWEfSearch)
+		if _, err := tx.Exec(ctx, SetVectorSearchSettingsQuery, strconv.Itoa(efSearch)); err != nil {
+			return fmt.Errorf("set vector search settings failed: %w", err)
+		}
+		return fn(tx)
+	})
+}
 
 // Ping verifies the provided database connection is available
 func (db *Database) Ping(ctx context.Context) error {
@@ -151,10 +374,73 @@ func (db *Database) ListCollections(
 	ctx, span := tracer.Start(ctx, "Database.ListCollections")
 	span.SetAttributes(attribute.Int("repos_len", len(args.Repos)))
 	defer span.End()
+	return db.listCollections(ctx, args.Repos, 0, 0)
+}
+
+// ListCollectionsPage retrieves a page of collections for the provided repos from the database,
+// ordered by ID, starting after args.PageToken.
+func (db *Database) ListCollectionsPage(
+	ctx context.Context,
+	args ListCollectionsPageArgs,
+) (*ListCollectionsPageResult, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.ListCollectionsPage")
+	span.SetAttributes(
+		attribute.Int("repos_len", len(args.Repos)),
+		attribute.Int("page_size", int(args.PageSize)),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return nil, fmt.Errorf("database connection not available")
+	}
+	scope := pageScope("collections", args.Repos)
+	cursor, err := db.tokens.Decode(scope, args.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	var afterID uint64
+	if cursor != nil {
+		afterID = cursor.ID
+	}
+	cols, err := db.listCollections(ctx, args.Repos, afterID, int(args.PageSize)+1)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	query, qargs, err := RenderCountCollectionsQuery(args.Repos)
+	if err != nil {
+		return nil, err
+	}
+	out := &ListCollectionsPageResult{Collections: cols}
+	if err := db.pg.QueryRow(ctx, query, qargs...).Scan(&out.TotalSize); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("count collections failed: %w", err)
+	}
+	if len(cols) > int(args.PageSize) {
+		out.Collections = cols[:args.PageSize]
+		last := out.Collections[len(out.Collections)-1]
+		if out.NextPageToken, err = db.tokens.Encode(PageCursor{Scope: scope, ID: last.GetId()}); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+func (db *Database) listCollections(
+	ctx context.Context,
+	repos []*myawesomelistv1.Repository,
+	afterID uint64,
+	limit int,
+) ([]*myawesomelistv1.Collection, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.listCollections")
+	defer span.End()
 	if db.pg == nil {
 		return nil, fmt.Errorf("database connection not available")
 	}
-	query, qargs, err := RenderListCollectionsQuery(args.Repos)
+	query, qargs, err := RenderListCollectionsQuery(repos, afterID, limit)
 	if err != nil {
 		return nil, err
 	}
@@ -197,22 +483,12 @@ func (db *Database) ListCollections(
 		CollectionID uint64
 		Name         string
 		UpdatedAt    time.Time
-	}
-	type projectRow struct {
-		ID           uint64
-		CategoryID   uint64
-		RepositoryID uint64
-		Name         string
-		Description  string
-		UpdatedAt    time.Time
-		Hostname     string
-		Owner        string
-		Repo         string
+		RemovedAt    *time.Time
 	}
 	// predeclare maps to assemble output later
 	catsByCol := make(map[uint64][]categoryRow)
-	pm := make(map[uint64][]projectRow)
-	catRows, err := db.pg.Query(ctx, CategoriesByCollectionIDsQuery, ids)
+	pm := make(map[uint64][]Project)
+	catRows, err := db.pg.Query(ctx, CategoriesByCollectionIDsQuery, ids, false)
 	if err == nil {
 		defer catRows.Close()
 		scannedCats, err := pgx.CollectRows(catRows, pgx.RowToStructByPos[categoryRow])
@@ -237,10 +513,10 @@ func (db *Database) ListCollections(
 		}
 	}
 	if len(catIDs) > 0 {
-		pr, err := db.pg.Query(ctx, ProjectsByCategoryIDsQuery, catIDs)
+		pr, err := db.pg.Query(ctx, ProjectsByCategoryIDsQuery, catIDs, false)
 		if err == nil {
 			defer pr.Close()
-			scannedProjs, err := pgx.CollectRows(pr, pgx.RowToStructByPos[projectRow])
+			scannedProjs, err := pgx.CollectRows(pr, scanProject)
 			if err != nil {
 				span.RecordError(err)
 				span.SetStatus(codes.Error, err.Error())
@@ -271,20 +547,7 @@ func (db *Database) ListCollections(
 		for _, cat := range catsByCol[col.ID] {
 			var ps []*myawesomelistv1.Project
 			for _, p := range pm[cat.ID] {
-				ps = append(
-					ps,
-					&myawesomelistv1.Project{
-						Id:          p.ID,
-						Name:        p.Name,
-						Description: p.Description,
-						Repo: &myawesomelistv1.Repository{
-							Hostname: p.Hostname,
-							Owner:    p.Owner,
-							Repo:     p.Repo,
-						},
-						UpdatedAt: timestamppb.New(p.UpdatedAt),
-					},
-				)
+				ps = append(ps, p.Proto())
 			}
 			pc.Categories = append(
 				pc.Categories,
@@ -302,14 +565,210 @@ func (db *Database) ListCollections(
 	return out, nil
 }
 
-// GetCollection retrieves a collection from the database
+// ListCategoriesPage retrieves a page of the categories of a collection with their projects,
+// ordered by ID, starting after args.PageToken.
+func (db *Database) ListCategoriesPage(
+	ctx context.Context,
+	args ListCategoriesPageArgs,
+) (*ListCategoriesPageResult, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.ListCategoriesPage")
+	span.SetAttributes(
+		attribute.String("owner", args.Repo.Owner),
+		attribute.String("repo", args.Repo.Repo),
+		attribute.Int("page_size", int(args.PageSize)),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return nil, fmt.Errorf("database connection not available")
+	}
+	scope := pageScope("categories", args.Repo, args.IncludeRemoved)
+	cursor, err := db.tokens.Decode(scope, args.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	var afterID uint64
+	if cursor != nil {
+		afterID = cursor.ID
+	}
+	repo := args.Repo
+	rows, err := db.pg.Query(
+		ctx,
+		CategoriesPageQuery,
+		repo.Hostname,
+		repo.Owner,
+		repo.Repo,
+		args.IncludeRemoved,
+		afterID,
+		int(args.PageSize)+1,
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("list categories failed: %w", err)
+	}
+	cats, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Category, error) {
+		var cat Category
+		err := row.Scan(&cat.ID, &cat.CollectionID, &cat.Name, &cat.UpdatedAt, &cat.RemovedAt)
+		return cat, err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	out := &ListCategoriesPageResult{}
+	if err := db.pg.QueryRow(
+		ctx,
+		CountCategoriesQuery,
+		repo.Hostname,
+		repo.Owner,
+		repo.Repo,
+		args.IncludeRemoved,
+	).Scan(&out.TotalSize); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("count categories failed: %w", err)
+	}
+	if len(cats) > int(args.PageSize) {
+		cats = cats[:args.PageSize]
+		if out.NextPageToken, err = db.tokens.Encode(PageCursor{Scope: scope, ID: cats[len(cats)-1].ID}); err != nil {
+			return nil, err
+		}
+	}
+	if len(cats) == 0 {
+		return out, nil
+	}
+	ids := make([]uint64, len(cats))
+	for i := range cats {
+		ids[i] = cats[i].ID
+	}
+	pr, err := db.pg.Query(ctx, ProjectsByCategoryIDsQuery, ids, args.IncludeRemoved)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("list category projects failed: %w", err)
+	}
+	projects, err := pgx.CollectRows(pr, scanProject)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	byCat := make(map[uint64][]*myawesomelistv1.Project, len(cats))
+	for i := range projects {
+		byCat[projects[i].CategoryID] = append(byCat[projects[i].CategoryID], projects[i].Proto())
+	}
+	for _, cat := range cats {
+		out.Categories = append(out.Categories, &myawesomelistv1.Category{
+			Id:        cat.ID,
+			Name:      cat.Name,
+			UpdatedAt: timestamppb.New(cat.UpdatedAt),
+			RemovedAt: timestamppbOrNil(cat.RemovedAt),
+			Projects:  byCat[cat.ID],
+		})
+	}
+	return out, nil
+}
+
+// ListProjectsPage retrieves a page of the projects of a collection category in args.OrderBy
+// order, starting after args.PageToken.
+func (db *Database) ListProjectsPage(
+	ctx context.Context,
+	args ListProjectsPageArgs,
+) (*ListProjectsPageResult, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.ListProjectsPage")
+	span.SetAttributes(
+		attribute.String("owner", args.Repo.Owner),
+		attribute.String("repo", args.Repo.Repo),
+		attribute.String("category", args.CategoryName),
+		attribute.String("order_by", args.OrderBy.String()),
+		attribute.Int("page_size", int(args.PageSize)),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return nil, fmt.Errorf("database connection not available")
+	}
+	scope := pageScope("projects", args.Repo, args.CategoryName, args.OrderBy, args.IncludeRemoved)
+	cursor, err := db.tokens.Decode(scope, args.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	query, queryArgs, err := RenderListProjectsQuery(args, cursor, int(args.PageSize)+1)
+	if err != nil {
+		return nil, err
+	}
+	slog.DebugContext(ctx, "list projects query", "sql", query, "args_len", len(queryArgs))
+	rows, err := db.pg.Query(ctx, query, queryArgs...)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("list projects failed: %w", err)
+	}
+	type keyedProject struct {
+		Project
+		key any
+	}
+	projects, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (keyedProject, error) {
+		var kp keyedProject
+		var err error
+		kp.Project, err = scanProjectWith(row, &kp.key)
+		return kp, err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	query, queryArgs, err = RenderCountProjectsQuery(args)
+	if err != nil {
+		return nil, err
+	}
+	out := &ListProjectsPageResult{}
+	if err := db.pg.QueryRow(ctx, query, queryArgs...).Scan(&out.TotalSize); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("count projects failed: %w", err)
+	}
+	if len(projects) > int(args.PageSize) {
+		projects = projects[:args.PageSize]
+		last := projects[len(projects)-1]
+		next := PageCursor{Scope: scope, ID: last.ID}
+		switch k := last.key.(type) {
+		case string:
+			next.Text = &k
+		case float64:
+			next.Number = &k
+		case time.Time:
+			next.Time = &k
+		default:
+			return nil, fmt.Errorf("unexpected project sort key %T", last.key)
+		}
+		if out.NextPageToken, err = db.tokens.Encode(next); err != nil {
+			return nil, err
+		}
+	}
+	for i := range projects {
+		out.Projects = append(out.Projects, projects[i].Proto())
+	}
+	return out, nil
+}
+
+// GetCollection retrieves a collection from the database.
+// Categories and projects removed from the README are skipped unless args.IncludeRemoved is set.
 func (db *Database) GetCollection(
 	ctx context.Context,
-	repo *myawesomelistv1.Repository,
+	args GetCollectionArgs,
 ) (*myawesomelistv1.Collection, error) {
 	tracer := otel.Tracer("myawesomelist/database")
 	ctx, span := tracer.Start(ctx, "Database.GetCollection")
-	span.SetAttributes(attribute.String("owner", repo.Owner), attribute.String("repo", repo.Repo))
+	repo := args.Repo
+	span.SetAttributes(
+		attribute.String("owner", repo.Owner),
+		attribute.String("repo", repo.Repo),
+		attribute.Bool("include_removed", args.IncludeRemoved),
+	)
 	defer span.End()
 	if db.pg == nil {
 		return nil, fmt.Errorf("database connection not available")
@@ -339,14 +798,15 @@ func (db *Database) GetCollection(
 	slog.DebugContext(ctx, "get collection", "repo_id", rid, "categories", len(col.Categories))
 	catRows, err := db.pg.Query(
 		ctx,
-		"SELECT id, collection_id, name, updated_at FROM categories WHERE collection_id=$1",
-		col.ID,
+		CategoriesByCollectionIDsQuery,
+		[]uint64{col.ID},
+		args.IncludeRemoved,
 	)
 	if err == nil {
 		defer catRows.Close()
 		for catRows.Next() {
 			var cat Category
-			if err := catRows.Scan(&cat.ID, &cat.CollectionID, &cat.Name, &cat.UpdatedAt); err != nil {
+			if err := catRows.Scan(&cat.ID, &cat.CollectionID, &cat.Name, &cat.UpdatedAt, &cat.RemovedAt); err != nil {
 				span.RecordError(err)
 				span.SetStatus(codes.Error, err.Error())
 				return nil, err
@@ -357,20 +817,41 @@ func (db *Database) GetCollection(
 	for i := range col.Categories {
 		pr, err := db.pg.Query(
 			ctx,
-			"SELECT p.id, p.category_id, p.repository_id, p.name, p.description, p.updated_at, r.hostname, r.owner, r.repo FROM projects p JOIN repositories r ON r.id=p.repository_id WHERE p.category_id=$1",
-			col.Categories[i].ID,
+			ProjectsByCategoryIDsQuery,
+			[]uint64{col.Categories[i].ID},
+			args.IncludeRemoved,
 		)
 		if err == nil {
 			defer pr.Close()
 			for pr.Next() {
 				var p Project
-				var h, o, rr string
-				if err := pr.Scan(&p.ID, &p.CategoryID, &p.RepositoryID, &p.Name, &p.Description, &p.UpdatedAt, &h, &o, &rr); err != nil {
+				var h, o, rr, st string
+				if err := pr.Scan(
+					&p.ID,
+					&p.CategoryID,
+					&p.RepositoryID,
+					&p.Name,
+					&p.Description,
+					&p.UpdatedAt,
+					&h,
+					&o,
+					&rr,
+					&st,
+					&p.Release.TagName,
+					&p.Release.Name,
+					&p.Release.PublishedAt,
+					&p.Release.Prerelease,
+					&p.Release.URL,
+					&p.HealthScore,
+					&p.DependentsCount,
+					&p.RemovedAt,
+					&p.FirstSeenAt,
+				); err != nil {
 					span.RecordError(err)
 					span.SetStatus(codes.Error, err.Error())
 					return nil, err
 				}
-				p.Repository = Repository{ID: p.RepositoryID, Hostname: h, Owner: o, Repo: rr}
+				p.Repository = Repository{ID: p.RepositoryID, Hostname: h, Owner: o, Repo: rr, Status: st}
 				col.Categories[i].Projects = append(col.Categories[i].Projects, p)
 			}
 		}
@@ -390,6 +871,7 @@ func (db *Database) GetCollection(
 			Id:        cat.ID,
 			Name:      cat.Name,
 			UpdatedAt: timestamppb.New(cat.UpdatedAt),
+			RemovedAt: timestamppbOrNil(cat.RemovedAt),
 			Projects: func() []*myawesomelistv1.Project {
 				var ps []*myawesomelistv1.Project
 				for _, p := range cat.Projects {
@@ -402,7 +884,13 @@ func (db *Database) GetCollection(
 							Owner:    p.Repository.Owner,
 							Repo:     p.Repository.Repo,
 						},
-						UpdatedAt: timestamppb.New(p.UpdatedAt),
+						UpdatedAt:       timestamppb.New(p.UpdatedAt),
+						Status:          RepositoryStatusFromString(p.Repository.Status),
+						LatestRelease:   p.Release.Proto(),
+						HealthScore:     p.HealthScore,
+						DependentsCount: p.DependentsCount,
+						RemovedAt:       timestamppbOrNil(p.RemovedAt),
+						FirstSeenAt:     timestamppb.New(p.FirstSeenAt),
 					})
 				}
 				return ps
@@ -466,93 +954,1231 @@ func (db *Database) UpsertCollections(
 			c.Projects = catArg.Projects
 			cats = append(cats, c)
 		}
+		prev, err := db.listCollectionEntries(ctx, colIDs[i])
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return fmt.Errorf("list collection entries failed: %w", err)
+		}
 		if err := db.UpsertCategories(ctx, cats); err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, err.Error())
 			return fmt.Errorf("upsert categories failed: %w", err)
 		}
 		slog.DebugContext(ctx, "upsert categories done", "count", len(cats))
+		if err := db.reconcileCollection(ctx, colIDs[i], prev, col.Categories); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return fmt.Errorf("reconcile collection failed: %w", err)
+		}
 	}
 	return nil
 }
 
-// SearchProjects executes a datastore-backed search across repositories.
-func (db *Database) SearchProjects(
+// collectionEventTypes maps collection event types to their stored representation.
+var collectionEventTypes = map[myawesomelistv1.CollectionEventType]string{
+	myawesomelistv1.CollectionEventType_COLLECTION_EVENT_TYPE_PROJECT_ADDED:              "project_added",
+	myawesomelistv1.CollectionEventType_COLLECTION_EVENT_TYPE_PROJECT_REMOVED:            "project_removed",
+	myawesomelistv1.CollectionEventType_COLLECTION_EVENT_TYPE_PROJECT_MOVED:              "project_moved",
+	myawesomelistv1.CollectionEventType_COLLECTION_EVENT_TYPE_PROJECT_DESCRIPTION_EDITED: "project_description_edited",
+}
+
+// CollectionEventTypeFromString parses a stored collection event type.
+func CollectionEventTypeFromString(s string) myawesomelistv1.CollectionEventType {
+	for t, name := range collectionEventTypes {
+		if name == s {
+			return t
+		}
+	}
+	return myawesomelistv1.CollectionEventType_COLLECTION_EVENT_TYPE_UNSPECIFIED
+}
+
+func (db *Database) listCollectionEntries(
 	ctx context.Context,
-	embeddings [][]float32,
-	limit uint32,
-	repos []*myawesomelistv1.Repository,
-) ([]*myawesomelistv1.Project, error) {
+	collectionID uint64,
+) ([]CollectionEntryResult, error) {
+	rows, err := db.pg.Query(ctx, CollectionEntriesQuery, collectionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	return pgx.CollectRows(rows, pgx.RowToStructByPos[CollectionEntryResult])
+}
+
+// reconcileCollection diffs the entries of a collection stored before an ingest against the
+// ingested categories, records the changes as collection events and marks the categories and
+// entries which are no longer listed as removed. The first ingest of a collection records no event.
+func (db *Database) reconcileCollection(
+	ctx context.Context,
+	collectionID uint64,
+	prev []CollectionEntryResult,
+	categories []UpsertCategoryArgs,
+) error {
 	tracer := otel.Tracer("myawesomelist/database")
-	ctx, span := tracer.Start(ctx, "Database.SearchProjects")
-	span.SetAttributes(
-		attribute.Bool("embedding_used", len(embeddings) > 0),
-		attribute.Int("repos_len", len(repos)),
-		attribute.Int("limit", int(limit)),
-	)
+	ctx, span := tracer.Start(ctx, "Database.reconcileCollection")
+	span.SetAttributes(attribute.Int("collection_id", int(collectionID)))
 	defer span.End()
-	if db.pg == nil {
-		return nil, fmt.Errorf("database connection not available")
+	names := make([]string, 0, len(categories))
+	for _, cat := range categories {
+		names = append(names, cat.Name)
 	}
-	var embedding *pgvector.Vector
-	if len(embeddings) > 0 {
-		v := pgvector.NewVector(embeddings[0])
-		embedding = &v
+	var events []InsertCollectionEventArgs
+	var removed []uint64
+	if len(prev) > 0 {
+		curr, err := db.listCollectionEntries(ctx, collectionID)
+		if err != nil {
+			return err
+		}
+		// Resolve ingested entries against the stored rows to learn their repository IDs.
+		stored := make(map[collectionEntryKey]CollectionEntryResult, len(curr))
+		for _, e := range curr {
+			stored[collectionEntryKeyOf(e.CategoryName, e.Hostname, e.Owner, e.Repo)] = e
+		}
+		var next []CollectionEntryResult
+		for _, cat := range categories {
+			for j := range cat.Projects {
+				r := &cat.Projects[j].Repository
+				k := collectionEntryKeyOf(cat.Name, r.Hostname, r.Owner, r.Repo)
+				if e, ok := stored[k]; ok {
+					next = append(next, e)
+				}
+			}
+		}
+		events, removed = diffCollectionEntries(prev, next)
 	}
-	slog.DebugContext(ctx, "search projects embedding", "used", embedding != nil)
-	query, args, err := RenderSearchProjectsQuery(repos, embedding, int(limit))
-	if err != nil {
-		return nil, err
+	span.SetAttributes(attribute.Int("events", len(events)), attribute.Int("removed", len(removed)))
+	b := &pgx.Batch{}
+	if len(removed) > 0 {
+		b.Queue(MarkProjectsRemovedQuery, removed)
 	}
-	slog.DebugContext(
-		ctx,
-		"search projects query",
-		"sql",
-		query,
+	b.Queue(MarkCategoriesRemovedQuery, collectionID, names)
+	for _, e := range events {
+		b.Queue(
+			InsertCollectionEventQuery,
+			collectionID,
+			e.RepositoryID,
+			collectionEventTypes[e.Type],
+			e.ProjectName,
+			e.CategoryName,
+			e.PreviousCategoryName,
+			e.Description,
+			e.PreviousDescription,
+		)
+	}
+	br := db.pg.SendBatch(ctx, b)
+	defer br.Close()
+	for range b.Len() {
+		if _, err := br.Exec(); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+	}
+	slog.DebugContext(
+		ctx,
+		"reconcile collection",
+		"collection_id",
+		collectionID,
+		"events",
+		len(events),
+		"removed",
+		len(removed),
+	)
+	return nil
+}
+
+type collectionEntryKey struct{ category, repo string }
+
+func collectionEntryKeyOf(category, hostname, owner, repo string) collectionEntryKey {
+	return collectionEntryKey{category: category, repo: hostname + "/" + owner + "/" + repo}
+}
+
+// diffCollectionEntries compares the entries of a collection before and after an ingest. A repository
+// leaving a category while entering another one is a move; remaining entries are additions or
+// removals. It returns the change events and the project IDs of the entries no longer listed.
+func diffCollectionEntries(
+	prev, next []CollectionEntryResult,
+) ([]InsertCollectionEventArgs, []uint64) {
+	type group struct {
+		prev, next map[string]CollectionEntryResult
+	}
+	groups := make(map[string]*group)
+	var repos []string
+	entry := func(e CollectionEntryResult) *group {
+		k := collectionEntryKeyOf(e.CategoryName, e.Hostname, e.Owner, e.Repo).repo
+		g, ok := groups[k]
+		if !ok {
+			g = &group{prev: map[string]CollectionEntryResult{}, next: map[string]CollectionEntryResult{}}
+			groups[k] = g
+			repos = append(repos, k)
+		}
+		return g
+	}
+	for _, e := range prev {
+		entry(e).prev[e.CategoryName] = e
+	}
+	for _, e := range next {
+		entry(e).next[e.CategoryName] = e
+	}
+	slices.Sort(repos)
+	var events []InsertCollectionEventArgs
+	var removed []uint64
+	for _, k := range repos {
+		g := groups[k]
+		var left, entered []string
+		for cat, p := range g.prev {
+			n, ok := g.next[cat]
+			switch {
+			case !ok:
+				left = append(left, cat)
+			case n.Description != p.Description:
+				events = append(events, InsertCollectionEventArgs{
+					RepositoryID:        n.RepositoryID,
+					Type:                myawesomelistv1.CollectionEventType_COLLECTION_EVENT_TYPE_PROJECT_DESCRIPTION_EDITED,
+					ProjectName:         n.Name,
+					CategoryName:        cat,
+					Description:         n.Description,
+					PreviousDescription: p.Description,
+				})
+			}
+		}
+		for cat := range g.next {
+			if _, ok := g.prev[cat]; !ok {
+				entered = append(entered, cat)
+			}
+		}
+		slices.Sort(left)
+		slices.Sort(entered)
+		for _, cat := range left {
+			removed = append(removed, g.prev[cat].ProjectID)
+		}
+		for len(left) > 0 && len(entered) > 0 {
+			p, n := g.prev[left[0]], g.next[entered[0]]
+			moved := InsertCollectionEventArgs{
+				RepositoryID:         n.RepositoryID,
+				Type:                 myawesomelistv1.CollectionEventType_COLLECTION_EVENT_TYPE_PROJECT_MOVED,
+				ProjectName:          n.Name,
+				CategoryName:         n.CategoryName,
+				PreviousCategoryName: p.CategoryName,
+				Description:          n.Description,
+			}
+			if p.Description != n.Description {
+				moved.PreviousDescription = p.Description
+			}
+			events = append(events, moved)
+			left, entered = left[1:], entered[1:]
+		}
+		for _, cat := range entered {
+			n := g.next[cat]
+			events = append(events, InsertCollectionEventArgs{
+				RepositoryID: n.RepositoryID,
+				Type:         myawesomelistv1.CollectionEventType_COLLECTION_EVENT_TYPE_PROJECT_ADDED,
+				ProjectName:  n.Name,
+				CategoryName: cat,
+				Description:  n.Description,
+			})
+		}
+		for _, cat := range left {
+			p := g.prev[cat]
+			events = append(events, InsertCollectionEventArgs{
+				RepositoryID: p.RepositoryID,
+				Type:         myawesomelistv1.CollectionEventType_COLLECTION_EVENT_TYPE_PROJECT_REMOVED,
+				ProjectName:  p.Name,
+				CategoryName: cat,
+				Description:  p.Description,
+			})
+		}
+	}
+	return events, removed
+}
+
+// GetCollectionID resolves the ID of the collection ingested from a repository.
+// It returns 0 when the collection was never ingested.
+func (db *Database) GetCollectionID(
+	ctx context.Context,
+	repo *myawesomelistv1.Repository,
+) (uint64, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.GetCollectionID")
+	span.SetAttributes(attribute.String("owner", repo.Owner), attribute.String("repo", repo.Repo))
+	defer span.End()
+	if db.pg == nil {
+		return 0, fmt.Errorf("database connection not available")
+	}
+	var rid, id uint64
+	if err := db.pg.QueryRow(ctx, RepoIDQuery, repo.Hostname, repo.Owner, repo.Repo).Scan(&rid); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to resolve repository: %w", err)
+	}
+	if err := db.pg.QueryRow(ctx, CollectionIDByRepoIDQuery, rid).Scan(&id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, fmt.Errorf("failed to resolve collection: %w", err)
+	}
+	return id, nil
+}
+
+// GetCollectionHistoryCursor returns the last README revision recorded for a collection,
+// or nil when its history was never walked.
+func (db *Database) GetCollectionHistoryCursor(
+	ctx context.Context,
+	collectionID uint64,
+) (*CollectionHistoryCursor, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.GetCollectionHistoryCursor")
+	span.SetAttributes(attribute.Int("collection_id", int(collectionID)))
+	defer span.End()
+	if db.pg == nil {
+		return nil, fmt.Errorf("database connection not available")
+	}
+	var cur CollectionHistoryCursor
+	if err := db.pg.QueryRow(ctx, CollectionHistoryCursorQuery, collectionID).
+		Scan(&cur.SHA, &cur.CommittedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("get collection history cursor failed: %w", err)
+	}
+	return &cur, nil
+}
+
+// UpsertCollectionHistoryCursor stores the last README revision recorded for a collection.
+func (db *Database) UpsertCollectionHistoryCursor(
+	ctx context.Context,
+	args UpsertCollectionHistoryCursorArgs,
+) error {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.UpsertCollectionHistoryCursor")
+	span.SetAttributes(
+		attribute.Int("collection_id", int(args.CollectionID)),
+		attribute.String("sha", args.SHA),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return fmt.Errorf("database connection not available")
+	}
+	if _, err := db.pg.Exec(
+		ctx,
+		UpsertCollectionHistoryCursorQuery,
+		args.CollectionID,
+		args.SHA,
+		args.CommittedAt,
+	); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("upsert collection history cursor failed: %w", err)
+	}
+	return nil
+}
+
+// RecordCollectionEntriesSeen records that the given repositories were listed in a collection at a
+// README revision, widening their first and last seen bounds.
+func (db *Database) RecordCollectionEntriesSeen(
+	ctx context.Context,
+	args RecordCollectionEntriesSeenArgs,
+) error {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.RecordCollectionEntriesSeen")
+	span.SetAttributes(
+		attribute.Int("collection_id", int(args.CollectionID)),
+		attribute.String("sha", args.SHA),
+		attribute.Int("repos_len", len(args.Repos)),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return fmt.Errorf("database connection not available")
+	}
+	if len(args.Repos) == 0 {
+		return nil
+	}
+	hostnames := make([]string, len(args.Repos))
+	owners := make([]string, len(args.Repos))
+	repos := make([]string, len(args.Repos))
+	for i, r := range args.Repos {
+		hostnames[i], owners[i], repos[i] = r.Hostname, r.Owner, r.Repo
+	}
+	if _, err := db.pg.Exec(
+		ctx,
+		RecordCollectionEntriesSeenQuery,
+		args.CollectionID,
+		hostnames,
+		owners,
+		repos,
+		args.CommittedAt,
+		args.SHA,
+	); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("record collection entries seen failed: %w", err)
+	}
+	return nil
+}
+
+// ListCollectionChanges lists the recorded collection changes, most recent first.
+func (db *Database) ListCollectionChanges(
+	ctx context.Context,
+	args ListCollectionChangesArgs,
+) ([]*myawesomelistv1.CollectionEvent, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.ListCollectionChanges")
+	span.SetAttributes(attribute.Int("limit", int(args.Limit)))
+	defer span.End()
+	if db.pg == nil {
+		return nil, fmt.Errorf("database connection not available")
+	}
+	var collectionRepoID *uint64
+	if args.Repo != nil {
+		var rid uint64
+		if err := db.pg.QueryRow(ctx, RepoIDQuery, args.Repo.Hostname, args.Repo.Owner, args.Repo.Repo).Scan(&rid); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("failed to resolve repository: %w", err)
+		}
+		collectionRepoID = &rid
+	}
+	rows, err := db.pg.Query(
+		ctx,
+		CollectionChangesQuery,
+		collectionRepoID,
+		args.Start,
+		args.End,
+		args.Limit,
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("list collection changes failed: %w", err)
+	}
+	defer rows.Close()
+	var out []*myawesomelistv1.CollectionEvent
+	for rows.Next() {
+		var typ string
+		var created time.Time
+		e := &myawesomelistv1.CollectionEvent{
+			CollectionRepo: &myawesomelistv1.Repository{},
+			ProjectRepo:    &myawesomelistv1.Repository{},
+		}
+		if err := rows.Scan(
+			&e.Id,
+			&typ,
+			&e.CollectionRepo.Hostname,
+			&e.CollectionRepo.Owner,
+			&e.CollectionRepo.Repo,
+			&e.ProjectRepo.Hostname,
+			&e.ProjectRepo.Owner,
+			&e.ProjectRepo.Repo,
+			&e.ProjectName,
+			&e.CategoryName,
+			&e.PreviousCategoryName,
+			&e.Description,
+			&e.PreviousDescription,
+			&created,
+		); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		e.Type = CollectionEventTypeFromString(typ)
+		e.CreatedAt = timestamppb.New(created)
+		out = append(out, e)
+	}
+	slog.DebugContext(ctx, "list collection changes", "count", len(out))
+	return out, rows.Err()
+}
+
+// SearchProjects executes a datastore-backed search across repositories, returning a page of
+// args.Limit projects starting after args.PageToken. Pages are cut by position in the fused
+// ranking of the searchProjectsCandidates best matches of each ranking, so the last page ends at
+// the edge of that window and the total size counts the window rather than every match.
+func (db *Database) SearchProjects(
+	ctx context.Context,
+	args SearchProjectsArgs,
+) (*SearchProjectsResult, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.SearchProjects")
+	embeddings, limit, repos := args.Embeddings, args.Limit, args.Repos
+	span.SetAttributes(
+		attribute.Bool("embedding_used", len(embeddings) > 0),
+		attribute.Int("query_len", len(args.Query)),
+		attribute.Int("repos_len", len(repos)),
+		attribute.Int("limit", int(limit)),
+		attribute.Bool("include_removed", args.IncludeRemoved),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return nil, fmt.Errorf("database connection not available")
+	}
+	// The query embedding may differ slightly between calls, so tokens are scoped to the query text.
+	scoped := args
+	scoped.Embeddings, scoped.Limit, scoped.PageToken = nil, 0, ""
+	scope := pageScope("search", scoped)
+	cursor, err := db.tokens.Decode(scope, args.PageToken)
+	if err != nil {
+		return nil, err
+	}
+	query, queryArgs, err := RenderSearchProjectsQuery(args, db.distance, cursor, int(limit)+1)
+	if err != nil {
+		return nil, err
+	}
+	slog.DebugContext(
+		ctx,
+		"search projects query",
+		"sql",
+		query,
 		"args_len",
-		len(args),
+		len(queryArgs),
 		"limit",
 		limit,
 	)
-	rows, err := db.pg.Query(ctx, query, args...)
+	out := &SearchProjectsResult{}
+	err = db.vectorSearch(ctx, searchProjectsCandidates, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, query, queryArgs...)
+		if err != nil {
+			return fmt.Errorf("search projects failed: %w", err)
+		}
+		defer rows.Close()
+		var lastID uint64
+		for rows.Next() {
+			var id uint64
+			var name, desc, host, owner, repo, status string
+			var updated time.Time
+			var rel ProjectRelease
+			var health *float64
+			var dependents uint32
+			var removed *time.Time
+			if err := rows.Scan(
+				&id,
+				&name,
+				&desc,
+				&updated,
+				&host,
+				&owner,
+				&repo,
+				&status,
+				&rel.TagName,
+				&rel.Name,
+				&rel.PublishedAt,
+				&rel.Prerelease,
+				&rel.URL,
+				&health,
+				&dependents,
+				&removed,
+				&out.TotalSize,
+			); err != nil {
+				return err
+			}
+			if limit > 0 && len(out.Projects) == int(limit) {
+				var offset uint32
+				if cursor != nil {
+					offset = cursor.Offset
+				}
+				next := PageCursor{Scope: scope, ID: lastID, Offset: offset + limit}
+				if out.NextPageToken, err = db.tokens.Encode(next); err != nil {
+					return err
+				}
+				break
+			}
+			lastID = id
+			out.Projects = append(out.Projects, &myawesomelistv1.Project{
+				Id:              id,
+				Name:            name,
+				Description:     desc,
+				Repo:            &myawesomelistv1.Repository{Hostname: host, Owner: owner, Repo: repo},
+				UpdatedAt:       timestamppb.New(updated),
+				Status:          RepositoryStatusFromString(status),
+				LatestRelease:   rel.Proto(),
+				HealthScore:     health,
+				DependentsCount: dependents,
+				RemovedAt:       timestamppbOrNil(removed),
+			})
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	slog.DebugContext(ctx, "search projects results", "count", len(out.Projects))
+	return out, nil
+}
+
+// FindSimilarProjects returns the projects whose embedding is nearest to the embedding of
+// args.ProjectID, or to the average embedding of args.Repo across the collections listing it,
+// without the source repository itself. It returns ErrEmbeddingNotFound when the source has no
+// embedding.
+func (db *Database) FindSimilarProjects(
+	ctx context.Context,
+	args FindSimilarProjectsArgs,
+) ([]*myawesomelistv1.SimilarProject, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.FindSimilarProjects")
+	span.SetAttributes(
+		attribute.Int64("project_id", int64(args.ProjectID)),
+		attribute.Bool("other_collections_only", args.OtherCollectionsOnly),
+		attribute.Int("languages_len", len(args.Languages)),
+		attribute.Int("limit", int(args.Limit)),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return nil, fmt.Errorf("database connection not available")
+	}
+	var hostname, owner, repo string
+	if args.Repo != nil {
+		hostname, owner, repo = args.Repo.Hostname, args.Repo.Owner, args.Repo.Repo
+	}
+	var model string
+	var embedding *pgvector.Vector
+	var repoIDs, collectionIDs []int64
+	if err := db.pg.QueryRow(
+		ctx,
+		SimilarProjectsSourceQuery,
+		args.ProjectID,
+		hostname,
+		owner,
+		repo,
+		args.Model,
+	).Scan(&model, &embedding, &repoIDs, &collectionIDs); errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrEmbeddingNotFound
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("load source embedding failed: %w", err)
+	}
+	if embedding == nil {
+		return nil, ErrEmbeddingNotFound
+	}
+	span.SetAttributes(attribute.String("model", model))
+	query, err := RenderSimilarProjectsQuery(model, len(embedding.Slice()), db.distance)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	languages := make([]string, len(args.Languages))
+	for i, l := range args.Languages {
+		languages[i] = strings.ToLower(l)
+	}
+	var out []*myawesomelistv1.SimilarProject
+	candidates := max(int(args.Limit), similarProjectsCandidates)
+	err = db.vectorSearch(ctx, candidates, func(tx pgx.Tx) error {
+		rows, err := tx.Query(
+			ctx,
+			query,
+			*embedding,
+			repoIDs,
+			args.OtherCollectionsOnly,
+			collectionIDs,
+			languages,
+			int(args.Limit),
+			candidates,
+		)
+		if err != nil {
+			return fmt.Errorf("find similar projects failed: %w", err)
+		}
+		out, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*myawesomelistv1.SimilarProject, error) {
+			var p Project
+			var h, o, rr, st string
+			sp := &myawesomelistv1.SimilarProject{}
+			if err := row.Scan(
+				&p.ID,
+				&p.Name,
+				&p.Description,
+				&p.UpdatedAt,
+				&h,
+				&o,
+				&rr,
+				&st,
+				&p.Release.TagName,
+				&p.Release.Name,
+				&p.Release.PublishedAt,
+				&p.Release.Prerelease,
+				&p.Release.URL,
+				&p.HealthScore,
+				&p.DependentsCount,
+				&p.RemovedAt,
+				&sp.Distance,
+				&sp.Language,
+			); err != nil {
+				return nil, err
+			}
+			p.Repository = Repository{Hostname: h, Owner: o, Repo: rr, Status: st}
+			sp.Project = p.Proto()
+			return sp, nil
+		})
+		return err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	slog.DebugContext(ctx, "find similar projects results", "count", len(out))
+	return out, nil
+}
+
+// Close closes the database connection
+
+// GetProjectStats retrieves project stats from the datastore
+func (db *Database) GetProjectStats(
+	ctx context.Context,
+	args GetProjectStatsArgs,
+) (*myawesomelistv1.ProjectStats, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.GetProjectStats")
+	span.SetAttributes(
+		attribute.String("owner", args.Repo.Owner),
+		attribute.String("repo", args.Repo.Repo),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return nil, fmt.Errorf("database connection not available")
+	}
+	var rid uint64
+	if err := db.pg.QueryRow(ctx, RepoIDQuery, args.Repo.Hostname, args.Repo.Owner, args.Repo.Repo).Scan(&rid); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to resolve repository: %w", err)
+	}
+	stats, err := scanProjectStats(db.pg.QueryRow(ctx, ProjectStatsByRepoIDQuery, rid))
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return nil, fmt.Errorf("search projects failed: %w", err)
+		return nil, fmt.Errorf("query project stats failed: %w", err)
+	}
+	if stats.RegistryStats, err = db.listProjectRegistryStats(ctx, rid); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (db *Database) GetProjectsStats(
+	ctx context.Context,
+	repos []*myawesomelistv1.Repository,
+) ([]*myawesomelistv1.ProjectStats, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.GetProjectsStats")
+	span.SetAttributes(attribute.Int("repos_len", len(repos)))
+	defer span.End()
+	if db.pg == nil {
+		return nil, fmt.Errorf("database connection not available")
+	}
+	out := make([]*myawesomelistv1.ProjectStats, 0, len(repos))
+	for _, repo := range repos {
+		var rid uint64
+		if err := db.pg.QueryRow(ctx, RepoIDQuery, repo.Hostname, repo.Owner, repo.Repo).Scan(&rid); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to resolve repository: %w", err)
+		}
+		stats, err := scanProjectStats(db.pg.QueryRow(ctx, ProjectStatsByRepoIDQuery, rid))
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				continue
+			}
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, fmt.Errorf("query project stats failed: %w", err)
+		}
+		if stats.RegistryStats, err = db.listProjectRegistryStats(ctx, rid); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		out = append(out, stats)
+	}
+	return out, nil
+}
+
+// scanProjectStats scans a row selected by ProjectStatsByRepoIDQuery into a ProjectStats message.
+func scanProjectStats(row pgx.Row) (*myawesomelistv1.ProjectStats, error) {
+	var ps ProjectStats
+	if err := row.Scan(
+		&ps.ID,
+		&ps.RepositoryID,
+		&ps.StargazersCount,
+		&ps.OpenIssueCount,
+		&ps.UpdatedAt,
+		&ps.Status,
+		&ps.StatusCheckedAt,
+		&ps.ForksCount,
+		&ps.SubscribersCount,
+		&ps.License,
+		&ps.Topics,
+		&ps.Language,
+		&ps.DefaultBranch,
+		&ps.CreatedAt,
+		&ps.PushedAt,
+		&ps.Archived,
+		&ps.Release.TagName,
+		&ps.Release.Name,
+		&ps.Release.PublishedAt,
+		&ps.Release.Prerelease,
+		&ps.Release.URL,
+		&ps.CadenceDays,
+		&ps.HealthScore,
+		&ps.ContributorsCount,
+		&ps.TopContributorShare,
+		&ps.BusFactor,
+	); err != nil {
+		return nil, err
+	}
+	stats := &myawesomelistv1.ProjectStats{
+		Id:                  ps.ID,
+		StargazersCount:     ps.StargazersCount,
+		OpenIssueCount:      ps.OpenIssueCount,
+		UpdatedAt:           timestamppb.New(ps.UpdatedAt),
+		Status:              RepositoryStatusFromString(ps.Status),
+		ForksCount:          ps.ForksCount,
+		SubscribersCount:    ps.SubscribersCount,
+		License:             ptr.Deref(ps.License, ""),
+		Topics:              ps.Topics,
+		Language:            ptr.Deref(ps.Language, ""),
+		DefaultBranch:       ptr.Deref(ps.DefaultBranch, ""),
+		Archived:            ps.Archived,
+		LatestRelease:       ps.Release.Proto(),
+		ReleaseCadenceDays:  ps.CadenceDays,
+		HealthScore:         ps.HealthScore,
+		ContributorsCount:   ps.ContributorsCount,
+		TopContributorShare: ps.TopContributorShare,
+		BusFactor:           ps.BusFactor,
+	}
+	if ps.StatusCheckedAt != nil {
+		stats.StatusCheckedAt = timestamppb.New(*ps.StatusCheckedAt)
+	}
+	if ps.CreatedAt != nil {
+		stats.CreatedAt = timestamppb.New(*ps.CreatedAt)
+	}
+	if ps.PushedAt != nil {
+		stats.PushedAt = timestamppb.New(*ps.PushedAt)
+	}
+	return stats, nil
+}
+
+// listProjectRegistryStats retrieves the registry stats of a repository.
+func (db *Database) listProjectRegistryStats(
+	ctx context.Context,
+	repositoryID uint64,
+) ([]*myawesomelistv1.RegistryStats, error) {
+	rows, err := db.pg.Query(ctx, ProjectRegistryStatsByRepoIDQuery, repositoryID)
+	if err != nil {
+		return nil, fmt.Errorf("query project registry stats failed: %w", err)
 	}
 	defer rows.Close()
-	var out []*myawesomelistv1.Project
+	var out []*myawesomelistv1.RegistryStats
 	for rows.Next() {
-		var id uint64
-		var name, desc, host, owner, repo string
 		var updated time.Time
-		if err := rows.Scan(&id, &name, &desc, &updated, &host, &owner, &repo); err != nil {
+		rs := &myawesomelistv1.RegistryStats{}
+		if err := rows.Scan(
+			&rs.Registry,
+			&rs.Package,
+			&rs.Downloads,
+			&rs.DownloadsPeriod,
+			&rs.LatestVersion,
+			&rs.VersionsCount,
+			&updated,
+		); err != nil {
 			return nil, err
 		}
-		out = append(out, &myawesomelistv1.Project{
-			Id:          id,
-			Name:        name,
-			Description: desc,
-			Repo:        &myawesomelistv1.Repository{Hostname: host, Owner: owner, Repo: repo},
-			UpdatedAt:   timestamppb.New(updated),
-		})
+		rs.UpdatedAt = timestamppb.New(updated)
+		out = append(out, rs)
+	}
+	return out, rows.Err()
+}
+
+// ListStaledProjectRegistryStats lists listed repositories whose stats on a registry need to be fetched again.
+func (db *Database) ListStaledProjectRegistryStats(
+	ctx context.Context,
+	args ListStaledProjectRegistryStatsArgs,
+) ([]StaledProjectRegistryStatsResult, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.ListStaledProjectRegistryStats")
+	span.SetAttributes(
+		attribute.String("registry", args.Registry),
+		attribute.StringSlice("languages", args.Languages),
+		attribute.String("ttl", args.TTL.String()),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return nil, fmt.Errorf("database connection not available")
+	}
+	languages := make([]string, len(args.Languages))
+	for i, l := range args.Languages {
+		languages[i] = strings.ToLower(l)
+	}
+	rows, err := db.pg.Query(
+		ctx,
+		ProjectsStaledRegistryStatsQuery,
+		args.Registry,
+		languages,
+		args.TTL.Seconds(),
+		args.Ecosystem,
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("list staled project registry stats query failed: %w", err)
+	}
+	defer rows.Close()
+	out, err := pgx.CollectRows(rows, pgx.RowToStructByPos[StaledProjectRegistryStatsResult])
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	slog.DebugContext(ctx, "list staled project registry stats", "registry", args.Registry, "count", len(out))
+	return out, nil
+}
+
+// UpsertProjectRegistryStats stores the stats of a repository package on a registry.
+// An empty package records that the repository has no package on the registry.
+func (db *Database) UpsertProjectRegistryStats(
+	ctx context.Context,
+	args UpsertProjectRegistryStatsArgs,
+) error {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.UpsertProjectRegistryStats")
+	span.SetAttributes(
+		attribute.Int("repo_id", int(args.RepositoryID)),
+		attribute.String("registry", args.Registry),
+		attribute.String("package", args.Package),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return fmt.Errorf("database connection not available")
+	}
+	slog.DebugContext(
+		ctx,
+		"upsert project registry stats",
+		"repo_id",
+		args.RepositoryID,
+		"registry",
+		args.Registry,
+		"package",
+		args.Package,
+	)
+	if _, err := db.pg.Exec(
+		ctx,
+		UpsertProjectRegistryStatsQuery,
+		args.RepositoryID,
+		args.Registry,
+		args.Package,
+		args.Downloads,
+		args.DownloadsPeriod,
+		args.LatestVersion,
+		args.VersionsCount,
+	); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("upsert project registry stats failed: %w", err)
+	}
+	return nil
+}
+
+// ListStaledProjectManifests lists listed repositories written in one of the given languages whose
+// manifest of the given ecosystem is missing or older than the TTL.
+func (db *Database) ListStaledProjectManifests(
+	ctx context.Context,
+	args ListStaledProjectManifestsArgs,
+) ([]StaledProjectManifestResult, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.ListStaledProjectManifests")
+	span.SetAttributes(
+		attribute.String("ecosystem", args.Ecosystem),
+		attribute.StringSlice("languages", args.Languages),
+		attribute.String("ttl", args.TTL.String()),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return nil, fmt.Errorf("database connection not available")
+	}
+	languages := make([]string, len(args.Languages))
+	for i, l := range args.Languages {
+		languages[i] = strings.ToLower(l)
+	}
+	rows, err := db.pg.Query(
+		ctx,
+		ProjectsStaledManifestsQuery,
+		args.Ecosystem,
+		languages,
+		args.TTL.Seconds(),
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("list staled project manifests query failed: %w", err)
+	}
+	defer rows.Close()
+	out, err := pgx.CollectRows(rows, pgx.RowToStructByPos[StaledProjectManifestResult])
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	slog.DebugContext(ctx, "list staled project manifests", "ecosystem", args.Ecosystem, "count", len(out))
+	return out, nil
+}
+
+// UpsertProjectManifest stores the package and dependencies parsed from a repository manifest.
+// An empty package records that the repository has no manifest of the ecosystem.
+func (db *Database) UpsertProjectManifest(
+	ctx context.Context,
+	args UpsertProjectManifestArgs,
+) error {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.UpsertProjectManifest")
+	span.SetAttributes(
+		attribute.Int("repo_id", int(args.RepositoryID)),
+		attribute.String("ecosystem", args.Ecosystem),
+		attribute.String("package", args.Package),
+		attribute.Int("dependencies_len", len(args.Dependencies)),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return fmt.Errorf("database connection not available")
+	}
+	if _, err := db.pg.Exec(
+		ctx,
+		UpsertProjectManifestQuery,
+		args.RepositoryID,
+		args.Ecosystem,
+		args.Package,
+		args.Dependencies,
+	); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("upsert project manifest failed: %w", err)
+	}
+	return nil
+}
+
+// RefreshProjectDependencies rebuilds the dependency edges between catalogued repositories from
+// the stored manifests. The batch runs in a single implicit transaction so readers never see an
+// empty graph.
+func (db *Database) RefreshProjectDependencies(ctx context.Context) error {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.RefreshProjectDependencies")
+	defer span.End()
+	if db.pg == nil {
+		return fmt.Errorf("database connection not available")
+	}
+	b := &pgx.Batch{}
+	b.Queue(DeleteProjectDependenciesQuery)
+	b.Queue(InsertProjectDependenciesQuery)
+	br := db.pg.SendBatch(ctx, b)
+	defer br.Close()
+	if _, err := br.Exec(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("delete project dependencies failed: %w", err)
+	}
+	tag, err := br.Exec()
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("insert project dependencies failed: %w", err)
+	}
+	span.SetAttributes(attribute.Int64("edges", tag.RowsAffected()))
+	slog.DebugContext(ctx, "refresh project dependencies", "edges", tag.RowsAffected())
+	return nil
+}
+
+// ListProjectDependencies lists the catalogued repositories the given repository depends on.
+func (db *Database) ListProjectDependencies(
+	ctx context.Context,
+	repo *myawesomelistv1.Repository,
+) ([]*myawesomelistv1.DependencyEdge, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.ListProjectDependencies")
+	span.SetAttributes(attribute.String("owner", repo.Owner), attribute.String("repo", repo.Repo))
+	defer span.End()
+	out, err := db.listDependencyEdges(ctx, DependenciesByRepoIDQuery, repo)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return out, err
+}
+
+// ListProjectDependents lists the catalogued repositories depending on the given repository.
+func (db *Database) ListProjectDependents(
+	ctx context.Context,
+	repo *myawesomelistv1.Repository,
+) ([]*myawesomelistv1.DependencyEdge, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.ListProjectDependents")
+	span.SetAttributes(attribute.String("owner", repo.Owner), attribute.String("repo", repo.Repo))
+	defer span.End()
+	out, err := db.listDependencyEdges(ctx, DependentsByRepoIDQuery, repo)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	return out, err
+}
+
+func (db *Database) listDependencyEdges(
+	ctx context.Context,
+	query string,
+	repo *myawesomelistv1.Repository,
+) ([]*myawesomelistv1.DependencyEdge, error) {
+	if db.pg == nil {
+		return nil, fmt.Errorf("database connection not available")
+	}
+	var rid uint64
+	if err := db.pg.QueryRow(ctx, RepoIDQuery, repo.Hostname, repo.Owner, repo.Repo).Scan(&rid); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to resolve repository: %w", err)
+	}
+	rows, err := db.pg.Query(ctx, query, rid)
+	if err != nil {
+		return nil, fmt.Errorf("list dependency edges failed: %w", err)
+	}
+	defer rows.Close()
+	var out []*myawesomelistv1.DependencyEdge
+	for rows.Next() {
+		e := &myawesomelistv1.DependencyEdge{Repo: &myawesomelistv1.Repository{}}
+		if err := rows.Scan(&e.Repo.Hostname, &e.Repo.Owner, &e.Repo.Repo, &e.Ecosystem, &e.Package); err != nil {
+			return nil, err
+		}
+		out = append(out, e)
+	}
+	return out, rows.Err()
+}
+
+// UpsertProjectStats stores project stats in the datastore, and appends their counts to the
+// stats history when args.RecordHistory is set.
+func (db *Database) UpsertProjectStats(
+	ctx context.Context,
+	args UpsertProjectStatsArgs,
+) error {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.UpsertProjectStats")
+	span.SetAttributes(
+		attribute.Int("repo_id", int(args.RepositoryID)),
+		attribute.Bool("record_history", args.RecordHistory),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return fmt.Errorf("database connection not available")
+	}
+	slog.DebugContext(ctx, "upsert project stats", "repo_id", args.RepositoryID)
+	b := &pgx.Batch{}
+	b.Queue(
+		UpsertProjectStatsQuery,
+		args.RepositoryID,
+		args.StargazersCount,
+		args.OpenIssueCount,
+		args.ForksCount,
+		args.SubscribersCount,
+		args.License,
+		args.Topics,
+		args.Language,
+		args.DefaultBranch,
+		args.CreatedAt,
+		args.PushedAt,
+		args.Archived,
+		args.ContributorsCount,
+		args.TopContributorShare,
+		args.BusFactor,
+	)
+	if args.RecordHistory {
+		b.Queue(
+			InsertProjectStatsHistoryQuery,
+			args.RepositoryID,
+			args.StargazersCount,
+			args.OpenIssueCount,
+			args.ForksCount,
+			args.SubscribersCount,
+		)
+	}
+	br := db.pg.SendBatch(ctx, b)
+	defer br.Close()
+	if _, err := br.Exec(); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("upsert project stats failed: %w", err)
+	}
+	if args.RecordHistory {
+		if _, err := br.Exec(); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return fmt.Errorf("insert project stats history failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// statsIntervalFields maps stats intervals to PostgreSQL date_trunc fields.
+var statsIntervalFields = map[myawesomelistv1.StatsInterval]string{
+	myawesomelistv1.StatsInterval_STATS_INTERVAL_HOUR:  "hour",
+	myawesomelistv1.StatsInterval_STATS_INTERVAL_DAY:   "day",
+	myawesomelistv1.StatsInterval_STATS_INTERVAL_WEEK:  "week",
+	myawesomelistv1.StatsInterval_STATS_INTERVAL_MONTH: "month",
+}
+
+// UpsertProjectRelease stores the latest release and release cadence of a repository.
+func (db *Database) UpsertProjectRelease(
+	ctx context.Context,
+	args UpsertProjectReleaseArgs,
+) error {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.UpsertProjectRelease")
+	span.SetAttributes(
+		attribute.Int("repo_id", int(args.RepositoryID)),
+		attribute.String("tag_name", args.TagName),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return fmt.Errorf("database connection not available")
+	}
+	slog.DebugContext(ctx, "upsert project release", "repo_id", args.RepositoryID, "tag_name", args.TagName)
+	if _, err := db.pg.Exec(
+		ctx,
+		UpsertProjectReleaseQuery,
+		args.RepositoryID,
+		args.TagName,
+		args.Name,
+		args.PublishedAt,
+		args.Prerelease,
+		args.URL,
+		args.CadenceDays,
+	); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("upsert project release failed: %w", err)
 	}
-	slog.DebugContext(ctx, "search projects results", "count", len(out))
-	return out, rows.Err()
+	return nil
 }
 
-// Close closes the database connection
-
-// GetProjectStats retrieves project stats from the datastore
-func (db *Database) GetProjectStats(
+// ListProjectStatsHistory retrieves the stats time series of a repository, oldest first.
+func (db *Database) ListProjectStatsHistory(
 	ctx context.Context,
-	args GetProjectStatsArgs,
-) (*myawesomelistv1.ProjectStats, error) {
+	args ListProjectStatsHistoryArgs,
+) ([]*myawesomelistv1.ProjectStatsPoint, error) {
 	tracer := otel.Tracer("myawesomelist/database")
-	ctx, span := tracer.Start(ctx, "Database.GetProjectStats")
+	ctx, span := tracer.Start(ctx, "Database.ListProjectStatsHistory")
 	span.SetAttributes(
 		attribute.String("owner", args.Repo.Owner),
 		attribute.String("repo", args.Repo.Repo),
+		attribute.String("interval", args.Interval.String()),
 	)
 	defer span.End()
 	if db.pg == nil {
@@ -565,93 +2191,231 @@ func (db *Database) GetProjectStats(
 		}
 		return nil, fmt.Errorf("failed to resolve repository: %w", err)
 	}
-	var id uint64
-	var stargazers *uint32
-	var openIssues *uint32
-	var updated time.Time
-	err := db.pg.QueryRow(ctx, ProjectStatsByRepoIDQuery, rid).
-		Scan(&id, &rid, &stargazers, &openIssues, &updated)
+	var field *string
+	if f, ok := statsIntervalFields[args.Interval]; ok {
+		field = &f
+	}
+	rows, err := db.pg.Query(ctx, ProjectStatsHistoryQuery, rid, args.Start, args.End, field)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
-		}
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return nil, fmt.Errorf("query project stats failed: %w", err)
+		return nil, fmt.Errorf("list project stats history failed: %w", err)
+	}
+	defer rows.Close()
+	var out []*myawesomelistv1.ProjectStatsPoint
+	for rows.Next() {
+		var recorded time.Time
+		p := &myawesomelistv1.ProjectStatsPoint{}
+		if err := rows.Scan(&recorded, &p.StargazersCount, &p.OpenIssueCount, &p.ForksCount, &p.SubscribersCount); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		p.RecordedAt = timestamppb.New(recorded)
+		out = append(out, p)
 	}
-	return &myawesomelistv1.ProjectStats{
-		Id:              id,
-		StargazersCount: stargazers,
-		OpenIssueCount:  openIssues,
-		UpdatedAt:       timestamppb.New(updated),
-	}, nil
+	slog.DebugContext(ctx, "list project stats history", "repo_id", rid, "points", len(out))
+	return out, rows.Err()
 }
 
-func (db *Database) GetProjectsStats(
+// trendingWindowIntervals maps trending windows to PostgreSQL intervals.
+var trendingWindowIntervals = map[myawesomelistv1.TrendingWindow]string{
+	myawesomelistv1.TrendingWindow_TRENDING_WINDOW_DAY:   "1 day",
+	myawesomelistv1.TrendingWindow_TRENDING_WINDOW_WEEK:  "7 days",
+	myawesomelistv1.TrendingWindow_TRENDING_WINDOW_MONTH: "30 days",
+}
+
+// ListTrendingProjects ranks projects by star gain over the requested window.
+func (db *Database) ListTrendingProjects(
 	ctx context.Context,
-	repos []*myawesomelistv1.Repository,
-) ([]*myawesomelistv1.ProjectStats, error) {
+	args ListTrendingProjectsArgs,
+) ([]*myawesomelistv1.TrendingProject, error) {
 	tracer := otel.Tracer("myawesomelist/database")
-	ctx, span := tracer.Start(ctx, "Database.GetProjectsStats")
-	span.SetAttributes(attribute.Int("repos_len", len(repos)))
+	ctx, span := tracer.Start(ctx, "Database.ListTrendingProjects")
+	span.SetAttributes(
+		attribute.String("window", args.Window.String()),
+		attribute.String("category", args.CategoryName),
+		attribute.String("language", args.Language),
+		attribute.Int("limit", int(args.Limit)),
+	)
 	defer span.End()
 	if db.pg == nil {
 		return nil, fmt.Errorf("database connection not available")
 	}
-	out := make([]*myawesomelistv1.ProjectStats, 0, len(repos))
-	for _, repo := range repos {
+	window, ok := trendingWindowIntervals[args.Window]
+	if !ok {
+		window = trendingWindowIntervals[myawesomelistv1.TrendingWindow_TRENDING_WINDOW_WEEK]
+	}
+	var collectionRepoID *uint64
+	if args.Repo != nil {
 		var rid uint64
-		if err := db.pg.QueryRow(ctx, RepoIDQuery, repo.Hostname, repo.Owner, repo.Repo).Scan(&rid); err != nil {
+		if err := db.pg.QueryRow(ctx, RepoIDQuery, args.Repo.Hostname, args.Repo.Owner, args.Repo.Repo).Scan(&rid); err != nil {
 			if errors.Is(err, pgx.ErrNoRows) {
-				continue
+				return nil, nil
 			}
 			return nil, fmt.Errorf("failed to resolve repository: %w", err)
 		}
+		collectionRepoID = &rid
+	}
+	var category, language *string
+	if args.CategoryName != "" {
+		category = &args.CategoryName
+	}
+	if args.Language != "" {
+		language = &args.Language
+	}
+	rows, err := db.pg.Query(
+		ctx,
+		TrendingProjectsQuery,
+		window,
+		collectionRepoID,
+		category,
+		language,
+		args.Limit,
+	)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("list trending projects failed: %w", err)
+	}
+	defer rows.Close()
+	var out []*myawesomelistv1.TrendingProject
+	for rows.Next() {
 		var id uint64
-		var stargazers *uint32
-		var openIssues *uint32
+		var name, desc, host, owner, repo, status string
 		var updated time.Time
-		err := db.pg.QueryRow(ctx, ProjectStatsByRepoIDQuery, rid).
-			Scan(&id, &rid, &stargazers, &openIssues, &updated)
-		if err != nil {
-			if errors.Is(err, pgx.ErrNoRows) {
-				continue
-			}
+		var rel ProjectRelease
+		var health *float64
+		var dependents uint32
+		tp := &myawesomelistv1.TrendingProject{}
+		if err := rows.Scan(
+			&id,
+			&name,
+			&desc,
+			&updated,
+			&host,
+			&owner,
+			&repo,
+			&status,
+			&rel.TagName,
+			&rel.Name,
+			&rel.PublishedAt,
+			&rel.Prerelease,
+			&rel.URL,
+			&health,
+			&dependents,
+			&tp.StargazersCount,
+			&tp.StargazersDelta,
+			&tp.Score,
+		); err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, err.Error())
-			return nil, fmt.Errorf("query project stats failed: %w", err)
+			return nil, err
 		}
-		out = append(
-			out,
-			&myawesomelistv1.ProjectStats{
-				Id:              id,
-				StargazersCount: stargazers,
-				OpenIssueCount:  openIssues,
-				UpdatedAt:       timestamppb.New(updated),
-			},
-		)
+		tp.Project = &myawesomelistv1.Project{
+			Id:              id,
+			Name:            name,
+			Description:     desc,
+			Repo:            &myawesomelistv1.Repository{Hostname: host, Owner: owner, Repo: repo},
+			UpdatedAt:       timestamppb.New(updated),
+			Status:          RepositoryStatusFromString(status),
+			LatestRelease:   rel.Proto(),
+			HealthScore:     health,
+			DependentsCount: dependents,
+		}
+		out = append(out, tp)
+	}
+	slog.DebugContext(ctx, "list trending projects", "window", window, "count", len(out))
+	return out, rows.Err()
+}
+
+// ListStaledProjectHealth lists the health signals of repositories whose health score needs to be recomputed.
+func (db *Database) ListStaledProjectHealth(
+	ctx context.Context,
+	args ListStaledProjectHealthArgs,
+) ([]StaledProjectHealthResult, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.ListStaledProjectHealth")
+	span.SetAttributes(attribute.String("ttl", args.TTL.String()))
+	defer span.End()
+	if db.pg == nil {
+		return nil, fmt.Errorf("database connection not available")
+	}
+	rows, err := db.pg.Query(ctx, ProjectsStaledHealthQuery, args.TTL.Seconds())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("list staled project health failed: %w", err)
+	}
+	defer rows.Close()
+	out, err := pgx.CollectRows(rows, pgx.RowToStructByPos[StaledProjectHealthResult])
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
 	}
+	slog.DebugContext(ctx, "list staled project health", "count", len(out))
 	return out, nil
 }
 
-// UpsertProjectStats stores project stats in the datastore
-func (db *Database) UpsertProjectStats(
+// UpdateProjectHealthScores stores computed health scores without touching the stats freshness.
+func (db *Database) UpdateProjectHealthScores(
 	ctx context.Context,
-	args UpsertProjectStatsArgs,
+	args []UpdateProjectHealthScoreArgs,
 ) error {
 	tracer := otel.Tracer("myawesomelist/database")
-	ctx, span := tracer.Start(ctx, "Database.UpsertProjectStats")
-	span.SetAttributes(attribute.Int("repo_id", int(args.RepositoryID)))
+	ctx, span := tracer.Start(ctx, "Database.UpdateProjectHealthScores")
+	span.SetAttributes(attribute.Int("scores_len", len(args)))
 	defer span.End()
-	slog.DebugContext(ctx, "upsert project stats", "repo_id", args.RepositoryID)
+	if db.pg == nil {
+		return fmt.Errorf("database connection not available")
+	}
+	if len(args) == 0 {
+		return nil
+	}
 	b := &pgx.Batch{}
-	b.Queue(UpsertProjectStatsQuery, args.RepositoryID, args.StargazersCount, args.OpenIssueCount)
+	for _, a := range args {
+		b.Queue(UpdateProjectHealthScoreQuery, a.RepositoryID, a.Score)
+	}
 	br := db.pg.SendBatch(ctx, b)
 	defer br.Close()
-	if _, err := br.Exec(); err != nil {
+	for range args {
+		if _, err := br.Exec(); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return fmt.Errorf("update project health score failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// UpdateRepositoryStatus records the latest known status of a repository and when it was checked.
+func (db *Database) UpdateRepositoryStatus(
+	ctx context.Context,
+	args UpdateRepositoryStatusArgs,
+) error {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.UpdateRepositoryStatus")
+	span.SetAttributes(
+		attribute.Int("repo_id", int(args.RepositoryID)),
+		attribute.String("status", RepositoryStatusToString(args.Status)),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return fmt.Errorf("database connection not available")
+	}
+	slog.DebugContext(
+		ctx,
+		"update repository status",
+		"repo_id",
+		args.RepositoryID,
+		"status",
+		RepositoryStatusToString(args.Status),
+	)
+	if _, err := db.pg.Exec(ctx, UpdateRepositoryStatusQuery, args.RepositoryID, RepositoryStatusToString(args.Status)); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return fmt.Errorf("upsert project stats failed: %w", err)
+		return fmt.Errorf("update repository status failed: %w", err)
 	}
 	return nil
 }
@@ -773,25 +2537,61 @@ func (db *Database) UpsertProjectMetadata(
 	return nil
 }
 
-func (db *Database) ListStaledProjectEmbeddings(
+// ListStaledProjectMetadata lists listed repositories whose README needs to be fetched again.
+func (db *Database) ListStaledProjectMetadata(
+	ctx context.Context,
+	args ListStaledProjectMetadataArgs,
+) ([]StaledProjectMetadataResult, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.ListStaledProjectMetadata")
+	span.SetAttributes(
+		attribute.String("hostname", args.Hostname),
+		attribute.String("ttl", args.TTL.String()),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return nil, fmt.Errorf("database connection not available")
+	}
+	rows, err := db.pg.Query(ctx, ProjectsStaledMetadataQuery, args.Hostname, args.TTL.Seconds())
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("list staled project metadata query failed: %w", err)
+	}
+	defer rows.Close()
+	out, err := pgx.CollectRows(rows, pgx.RowToStructByPos[StaledProjectMetadataResult])
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	slog.DebugContext(ctx, "list staled project metadata", "count", len(out))
+	return out, nil
+}
+
+// ListProjectEmbeddingInputs returns the fields embedded for every listed project, with the
+// input hash of its embedding from args.Model and whether that embedding is older than args.TTL.
+// A negative TTL never expires embeddings.
+func (db *Database) ListProjectEmbeddingInputs(
 	ctx context.Context,
-	args ListStaledProjectEmbeddingsArgs,
-) ([]StaledProjectEmbeddingResult, error) {
+	args ListProjectEmbeddingInputsArgs,
+) ([]ProjectEmbeddingInputResult, error) {
 	tracer := otel.Tracer("myawesomelist/database")
-	ctx, span := tracer.Start(ctx, "Database.ListStaledProjectEmbeddings")
+	ctx, span := tracer.Start(ctx, "Database.ListProjectEmbeddingInputs")
 	defer span.End()
 	if db.pg == nil {
 		return nil, fmt.Errorf("database connection not available")
 	}
+	span.SetAttributes(attribute.String("model", args.Model))
 	ttlSeconds := int64(args.TTL.Seconds())
-	pr, err := db.pg.Query(ctx, ProjectsStaledEmbeddingsQuery, ttlSeconds)
+	pr, err := db.pg.Query(ctx, ProjectEmbeddingInputsQuery, ttlSeconds, args.Model)
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
-		return nil, fmt.Errorf("list staled project embeddings query failed: %w", err)
+		return nil, fmt.Errorf("list project embedding inputs query failed: %w", err)
 	}
 	defer pr.Close()
-	rows, err := pgx.CollectRows(pr, pgx.RowToStructByPos[StaledProjectEmbeddingResult])
+	rows, err := pgx.CollectRows(pr, pgx.RowToStructByPos[ProjectEmbeddingInputResult])
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
@@ -806,16 +2606,291 @@ func (db *Database) UpsertProjectEmbedding(
 ) error {
 	tracer := otel.Tracer("myawesomelist/database")
 	ctx, span := tracer.Start(ctx, "Database.UpsertProjectEmbedding")
-	span.SetAttributes(attribute.Int("vector_dim", len(args.Vec)))
+	span.SetAttributes(
+		attribute.String("model", args.Model),
+		attribute.Int("vector_dim", len(args.Vec)),
+	)
 	defer span.End()
 	if db.pg == nil {
 		return fmt.Errorf("database connection not available")
 	}
 	v := pgvector.NewVector(args.Vec)
-	if _, err := db.pg.Exec(ctx, UpsertProjectEmbeddingQuery, args.ProjectID, v); err != nil {
+	if _, err := db.pg.Exec(ctx, UpsertProjectEmbeddingQuery, args.ProjectID, args.Model, v, args.InputHash); err != nil {
 		span.RecordError(err)
 		span.SetStatus(codes.Error, err.Error())
 		return fmt.Errorf("upsert project embedding failed: %w", err)
 	}
 	return nil
 }
+
+// AdoptLegacyProjectEmbeddings records args.Model as the model of the embeddings stored before
+// models were recorded, provided they have args.Dimensions dimensions. It returns the number of
+// adopted embeddings.
+func (db *Database) AdoptLegacyProjectEmbeddings(
+	ctx context.Context,
+	args AdoptLegacyProjectEmbeddingsArgs,
+) (int64, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.AdoptLegacyProjectEmbeddings")
+	span.SetAttributes(
+		attribute.String("model", args.Model),
+		attribute.Int("dimensions", args.Dimensions),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return 0, fmt.Errorf("database connection not available")
+	}
+	tag, err := db.pg.Exec(ctx, AdoptLegacyProjectEmbeddingsQuery, args.Model, args.Dimensions)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, fmt.Errorf("adopt legacy project embeddings failed: %w", err)
+	}
+	span.SetAttributes(attribute.Int64("adopted", tag.RowsAffected()))
+	return tag.RowsAffected(), nil
+}
+
+// GetQueryEmbedding returns the stored embedding of args.Query by args.Model, or nil when it is
+// not stored.
+func (db *Database) GetQueryEmbedding(ctx context.Context, args GetQueryEmbeddingArgs) ([]float32, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.GetQueryEmbedding")
+	span.SetAttributes(attribute.String("model", args.Model))
+	defer span.End()
+	if db.pg == nil {
+		return nil, fmt.Errorf("database connection not available")
+	}
+	var v pgvector.Vector
+	if err := db.pg.QueryRow(ctx, QueryEmbeddingQuery, args.Model, args.Query).Scan(&v); errors.Is(err, pgx.ErrNoRows) {
+		span.SetAttributes(attribute.Bool("found", false))
+		return nil, nil
+	} else if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("get query embedding failed: %w", err)
+	}
+	span.SetAttributes(attribute.Bool("found", true))
+	return v.Slice(), nil
+}
+
+// UpsertQueryEmbedding stores the embedding of args.Query by args.Model.
+func (db *Database) UpsertQueryEmbedding(ctx context.Context, args UpsertQueryEmbeddingArgs) error {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.UpsertQueryEmbedding")
+	span.SetAttributes(
+		attribute.String("model", args.Model),
+		attribute.Int("vector_dim", len(args.Vec)),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return fmt.Errorf("database connection not available")
+	}
+	if _, err := db.pg.Exec(
+		ctx,
+		UpsertQueryEmbeddingQuery,
+		args.Model,
+		args.Query,
+		pgvector.NewVector(args.Vec),
+	); err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("upsert query embedding failed: %w", err)
+	}
+	return nil
+}
+
+// DeleteQueryEmbeddings deletes the query embeddings of every model but args.KeepModel, and
+// those unused for longer than args.UnusedFor when it is not negative. It returns the number of
+// deleted embeddings.
+func (db *Database) DeleteQueryEmbeddings(
+	ctx context.Context,
+	args DeleteQueryEmbeddingsArgs,
+) (int64, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.DeleteQueryEmbeddings")
+	span.SetAttributes(attribute.String("keep_model", args.KeepModel))
+	defer span.End()
+	if db.pg == nil {
+		return 0, fmt.Errorf("database connection not available")
+	}
+	tag, err := db.pg.Exec(ctx, DeleteQueryEmbeddingsQuery, args.KeepModel, int64(args.UnusedFor.Seconds()))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, fmt.Errorf("delete query embeddings failed: %w", err)
+	}
+	span.SetAttributes(attribute.Int64("deleted", tag.RowsAffected()))
+	return tag.RowsAffected(), nil
+}
+
+// RebuildEmbeddingIndexes builds an approximate nearest neighbour index over the embeddings of
+// each stored model with args.Method, comparing embeddings with the configured distance, and
+// drops the indexes of models without embeddings. Indexes are built concurrently and swapped
+// with the previous ones, so search keeps being served during the rebuild. Models whose
+// embeddings are too large to be indexed are skipped. It returns the built indexes.
+func (db *Database) RebuildEmbeddingIndexes(
+	ctx context.Context,
+	args RebuildEmbeddingIndexesArgs,
+) ([]EmbeddingIndexResult, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.RebuildEmbeddingIndexes")
+	span.SetAttributes(
+		attribute.String("method", string(args.Method)),
+		attribute.String("distance", string(db.distance)),
+	)
+	defer span.End()
+	if db.pg == nil {
+		return nil, fmt.Errorf("database connection not available")
+	}
+	rows, err := db.pg.Query(ctx, ProjectEmbeddingModelsQuery)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("list embedding models failed: %w", err)
+	}
+	models, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (EmbeddingIndexResult, error) {
+		var r EmbeddingIndexResult
+		err := row.Scan(&r.Model, &r.Dimensions, &r.Count)
+		return r, err
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	var out []EmbeddingIndexResult
+	keep := make(map[string]bool)
+	for _, m := range models {
+		if keep[m.Model] {
+			// Rows are ordered by count, so the main dimensions of a model come first.
+			slog.WarnContext(ctx, "embeddings of a model have mixed dimensions; index the most common", "model", m.Model, "dimensions", m.Dimensions)
+			continue
+		}
+		if args.Method == VectorIndexNone {
+			continue
+		}
+		if !vectorIndexable(m.Dimensions) {
+			slog.WarnContext(ctx, "embeddings too large to be indexed", "model", m.Model, "dimensions", m.Dimensions)
+			continue
+		}
+		m.Name = embeddingIndexName(m.Model)
+		m.Method = args.Method
+		m.Options = embeddingIndexOptions(args, m.Count)
+		if err := db.swapEmbeddingIndex(ctx, m); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, err
+		}
+		keep[m.Model] = true
+		out = append(out, m)
+	}
+	rows, err = db.pg.Query(ctx, ProjectEmbeddingIndexesQuery)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, fmt.Errorf("list embedding indexes failed: %w", err)
+	}
+	indexes, err := pgx.CollectRows(rows, pgx.RowToStructByPos[struct {
+		Name  string
+		Model string
+	}])
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return nil, err
+	}
+	for _, idx := range indexes {
+		if keep[idx.Model] {
+			continue
+		}
+		if _, err := db.pg.Exec(ctx, RenderDropEmbeddingIndexQuery(idx.Name)); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, fmt.Errorf("drop embedding index %s failed: %w", idx.Name, err)
+		}
+		if _, err := db.pg.Exec(ctx, DeleteProjectEmbeddingIndexQuery, idx.Name); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return nil, fmt.Errorf("delete embedding index %s failed: %w", idx.Name, err)
+		}
+		slog.InfoContext(ctx, "dropped embedding index", "name", idx.Name, "model", idx.Model)
+	}
+	span.SetAttributes(attribute.Int("indexes", len(out)))
+	return out, nil
+}
+
+// swapEmbeddingIndex builds the index of m under a temporary name and replaces the current one.
+func (db *Database) swapEmbeddingIndex(ctx context.Context, m EmbeddingIndexResult) error {
+	tmp := m.Name + "_new"
+	// An interrupted rebuild leaves an invalid temporary index behind.
+	if _, err := db.pg.Exec(ctx, RenderDropEmbeddingIndexQuery(tmp)); err != nil {
+		return fmt.Errorf("drop embedding index %s failed: %w", tmp, err)
+	}
+	slog.InfoContext(ctx, "building embedding index", "name", m.Name, "model", m.Model, "dimensions", m.Dimensions, "count", m.Count, "options", m.Options)
+	if _, err := db.pg.Exec(
+		ctx,
+		RenderCreateEmbeddingIndexQuery(tmp, m.Model, m.Dimensions, m.Method, db.distance, m.Options),
+	); err != nil {
+		return fmt.Errorf("build embedding index %s failed: %w", m.Name, err)
+	}
+	if _, err := db.pg.Exec(ctx, RenderDropEmbeddingIndexQuery(m.Name)); err != nil {
+		return fmt.Errorf("drop embedding index %s failed: %w", m.Name, err)
+	}
+	if _, err := db.pg.Exec(ctx, RenderRenameEmbeddingIndexQuery(tmp, m.Name)); err != nil {
+		return fmt.Errorf("rename embedding index %s failed: %w", tmp, err)
+	}
+	if _, err := db.pg.Exec(
+		ctx,
+		UpsertProjectEmbeddingIndexQuery,
+		m.Name,
+		m.Model,
+		m.Dimensions,
+		string(m.Method),
+		string(db.distance),
+		m.Options,
+	); err != nil {
+		return fmt.Errorf("record embedding index %s failed: %w", m.Name, err)
+	}
+	return nil
+}
+
+// embeddingIndexOptions returns the storage parameters of an index over count embeddings.
+// Without args.Lists, IVFFlat lists follow the pgvector guidance of count / 1000 up to a
+// million rows and the square root of count beyond.
+func embeddingIndexOptions(args RebuildEmbeddingIndexesArgs, count int64) string {
+	if args.Method == VectorIndexIVFFlat {
+		lists := args.Lists
+		if lists <= 0 {
+			if count <= 1_000_000 {
+				lists = int(max(count/1000, 1))
+			} else {
+				lists = int(math.Sqrt(float64(count)))
+			}
+		}
+		return fmt.Sprintf("lists = %d", lists)
+	}
+	return fmt.Sprintf("m = %d, ef_construction = %d", args.M, args.EfConstruction)
+}
+
+// DeleteProjectEmbeddings deletes the embeddings of every model but args.KeepModel. It returns
+// the number of deleted embeddings.
+func (db *Database) DeleteProjectEmbeddings(
+	ctx context.Context,
+	args DeleteProjectEmbeddingsArgs,
+) (int64, error) {
+	tracer := otel.Tracer("myawesomelist/database")
+	ctx, span := tracer.Start(ctx, "Database.DeleteProjectEmbeddings")
+	span.SetAttributes(attribute.String("keep_model", args.KeepModel))
+	defer span.End()
+	if db.pg == nil {
+		return 0, fmt.Errorf("database connection not available")
+	}
+	tag, err := db.pg.Exec(ctx, DeleteProjectEmbeddingsQuery, args.KeepModel)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return 0, fmt.Errorf("delete project embeddings failed: %w", err)
+	}
+	span.SetAttributes(attribute.Int64("deleted", tag.RowsAffected()))
+	return tag.RowsAffected(), nil
+}
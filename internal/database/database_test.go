@@ -0,0 +1,103 @@
+package database
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	myawesomelistv1 "myawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1"
+)
+
+// selectColumns returns the top-level select list of query.
+func selectColumns(t *testing.T, query string) []string {
+	t.Helper()
+	rest, ok := strings.CutPrefix(query, "SELECT ")
+	if !ok {
+		t.Fatalf("query does not start with SELECT: %s", query)
+	}
+	var cols []string
+	depth, start := 0, 0
+	for i := 0; i < len(rest); i++ {
+		switch rest[i] {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				cols = append(cols, strings.TrimSpace(rest[start:i]))
+				start = i + 1
+			}
+		case ' ':
+			if depth == 0 && strings.HasPrefix(rest[i:], " FROM ") {
+				return append(cols, strings.TrimSpace(rest[start:i]))
+			}
+		}
+	}
+	t.Fatalf("query has no top-level FROM: %s", query)
+	return nil
+}
+
+// fakeRow is a row of the columns of a query, checking that it is scanned into as many pointers.
+type fakeRow struct {
+	cols []string
+}
+
+func (r fakeRow) FieldDescriptions() []pgconn.FieldDescription {
+	fds := make([]pgconn.FieldDescription, len(r.cols))
+	for i, c := range r.cols {
+		fds[i] = pgconn.FieldDescription{Name: c}
+	}
+	return fds
+}
+
+func (r fakeRow) Scan(dest ...any) error {
+	if len(dest) != len(r.cols) {
+		return fmt.Errorf("number of field descriptions must equal number of destinations, got %d and %d", len(r.cols), len(dest))
+	}
+	for i, d := range dest {
+		if v := reflect.ValueOf(d); v.Kind() != reflect.Pointer || v.IsNil() {
+			return fmt.Errorf("destination %d of column %s is not a pointer", i, r.cols[i])
+		}
+	}
+	return nil
+}
+
+func (r fakeRow) Values() ([]any, error) { return make([]any, len(r.cols)), nil }
+
+func (r fakeRow) RawValues() [][]byte { return make([][]byte, len(r.cols)) }
+
+func (r fakeRow) Conn() *pgx.Conn { return nil }
+
+func TestScanProjectScansProjectsByCategoryIDsQuery(t *testing.T) {
+	row := fakeRow{cols: selectColumns(t, ProjectsByCategoryIDsQuery)}
+	if _, err := scanProject(row); err != nil {
+		t.Fatalf("scanProject: %v", err)
+	}
+}
+
+func TestStaledProjectRegistryStatsResultScansProjectsStaledRegistryStatsQuery(t *testing.T) {
+	row := fakeRow{cols: selectColumns(t, ProjectsStaledRegistryStatsQuery)}
+	if _, err := pgx.RowToStructByPos[StaledProjectRegistryStatsResult](row); err != nil {
+		t.Fatalf("RowToStructByPos: %v", err)
+	}
+}
+
+func TestScanProjectWithScansListProjectsQuery(t *testing.T) {
+	query, _, err := RenderListProjectsQuery(ListProjectsPageArgs{Repo: &myawesomelistv1.Repository{}}, nil, 10)
+	if err != nil {
+		t.Fatalf("RenderListProjectsQuery: %v", err)
+	}
+	i := strings.LastIndex(query, "SELECT id, ")
+	if i < 0 {
+		t.Fatalf("query has no outer select: %s", query)
+	}
+	row := fakeRow{cols: selectColumns(t, query[i:])}
+	var key any
+	if _, err := scanProjectWith(row, &key); err != nil {
+		t.Fatalf("scanProjectWith: %v", err)
+	}
+}
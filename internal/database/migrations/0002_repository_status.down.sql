@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS idx_repositories_status;
+ALTER TABLE repositories DROP CONSTRAINT IF EXISTS repositories_status_check;
+ALTER TABLE repositories
+    DROP COLUMN IF EXISTS status_checked_at,
+    DROP COLUMN IF EXISTS status;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS project_stats_history (
+    id BIGSERIAL PRIMARY KEY,
+    repository_id BIGINT NOT NULL,
+    stargazers_count INTEGER,
+    open_issue_count INTEGER,
+    forks_count INTEGER,
+    subscribers_count INTEGER,
+    recorded_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (repository_id) REFERENCES repositories(id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_project_stats_history_repository_recorded_at
+    ON project_stats_history(repository_id, recorded_at);
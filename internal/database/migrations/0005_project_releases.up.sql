@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS project_releases (
+    id BIGSERIAL PRIMARY KEY,
+    repository_id BIGINT NOT NULL,
+    tag_name VARCHAR(255),
+    name TEXT,
+    published_at TIMESTAMPTZ,
+    prerelease BOOLEAN NOT NULL DEFAULT FALSE,
+    html_url TEXT,
+    cadence_days DOUBLE PRECISION,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (repository_id),
+    FOREIGN KEY (repository_id) REFERENCES repositories(id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
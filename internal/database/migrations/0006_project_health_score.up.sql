@@ -0,0 +1,4 @@
+ALTER TABLE project_stats
+    ADD COLUMN IF NOT EXISTS health_score DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS health_scored_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS idx_project_stats_health_score ON project_stats(health_score);
@@ -0,0 +1,4 @@
+ALTER TABLE project_stats
+    DROP COLUMN IF EXISTS bus_factor,
+    DROP COLUMN IF EXISTS top_contributor_share,
+    DROP COLUMN IF EXISTS contributors_count;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS project_registry_stats (
+    id BIGSERIAL PRIMARY KEY,
+    repository_id BIGINT NOT NULL,
+    registry VARCHAR(32) NOT NULL,
+    package VARCHAR(255),
+    downloads BIGINT,
+    downloads_period VARCHAR(32),
+    latest_version VARCHAR(255),
+    versions_count INTEGER,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (repository_id, registry),
+    FOREIGN KEY (repository_id) REFERENCES repositories(id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS collection_events (
+    id BIGSERIAL PRIMARY KEY,
+    collection_id BIGINT NOT NULL,
+    repository_id BIGINT NOT NULL,
+    type VARCHAR(32) NOT NULL,
+    project_name VARCHAR(255) NOT NULL,
+    category_name VARCHAR(255) NOT NULL,
+    previous_category_name VARCHAR(255),
+    description TEXT,
+    previous_description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (collection_id) REFERENCES collections(id) ON DELETE CASCADE,
+    FOREIGN KEY (repository_id) REFERENCES repositories(id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_collection_events_collection_id_created_at
+    ON collection_events(collection_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_collection_events_created_at
+    ON collection_events(created_at DESC);
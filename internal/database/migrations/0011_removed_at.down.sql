@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_projects_listed;
+ALTER TABLE projects DROP COLUMN IF EXISTS removed_at;
+ALTER TABLE categories DROP COLUMN IF EXISTS removed_at;
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_projects_search_vector;
+ALTER TABLE projects DROP COLUMN IF EXISTS search_vector;
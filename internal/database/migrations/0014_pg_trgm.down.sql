@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_projects_name_trgm;
+DROP EXTENSION IF EXISTS pg_trgm;
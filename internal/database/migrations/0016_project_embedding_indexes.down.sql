@@ -0,0 +1,10 @@
+DO $$
+DECLARE
+    idx TEXT;
+BEGIN
+    FOR idx IN SELECT name FROM project_embedding_indexes LOOP
+        EXECUTE format('DROP INDEX IF EXISTS %I', idx);
+    END LOOP;
+END
+$$;
+DROP TABLE IF EXISTS project_embedding_indexes;
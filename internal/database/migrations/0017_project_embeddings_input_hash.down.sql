@@ -0,0 +1 @@
+ALTER TABLE project_embeddings DROP COLUMN IF EXISTS input_hash;
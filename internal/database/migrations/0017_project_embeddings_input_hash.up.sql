@@ -0,0 +1,3 @@
+-- Hash of the exact text and model an embedding was computed from, so that unchanged projects
+-- are not embedded again.
+ALTER TABLE project_embeddings ADD COLUMN IF NOT EXISTS input_hash TEXT;
@@ -2,12 +2,15 @@ package pgx
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"myawesomelist.shikanime.studio/internal/config"
 )
 
 // NewClientForConfig creates a pgxpool.Pool using DSN information from cfg.
+// Connections are set up with the HNSW and IVFFlat search parameters of cfg.
 func NewClientForConfig(cfg *config.Config) (*pgxpool.Pool, error) {
 	if err := cfg.Bind(); err != nil {
 		return nil, err
@@ -19,5 +22,26 @@ func NewClientForConfig(cfg *config.Config) (*pgxpool.Pool, error) {
 	if dsnURL.Scheme != "postgres" && dsnURL.Scheme != "postgresql" {
 		return nil, err
 	}
-	return pgxpool.New(context.Background(), dsnURL.String())
+	pc, err := pgxpool.ParseConfig(dsnURL.String())
+	if err != nil {
+		return nil, err
+	}
+	var settings []string
+	if v := cfg.GetHNSWEfSearch(); v > 0 {
+		settings = append(settings, fmt.Sprintf("SET hnsw.ef_search = %d", v))
+	}
+	if v := cfg.GetIVFFlatProbes(); v > 0 {
+		settings = append(settings, fmt.Sprintf("SET ivfflat.probes = %d", v))
+	}
+	if len(settings) > 0 {
+		pc.AfterConnect = func(ctx context.Context, conn *pgx.Conn) error {
+			for _, s := range settings {
+				if _, err := conn.Exec(ctx, s); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+	return pgxpool.NewWithConfig(context.Background(), pc)
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/pgvector/pgvector-go"
+	"k8s.io/utils/ptr"
 	myawesomelistv1 "myawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1"
 )
 
@@ -49,11 +50,101 @@ type UpsertCollectionArgs struct {
 	Categories []UpsertCategoryArgs
 }
 
+// CollectionEntryResult is a project as listed under a category of a collection.
+type CollectionEntryResult struct {
+	ProjectID    uint64
+	CategoryName string
+	RepositoryID uint64
+	Hostname     string
+	Owner        string
+	Repo         string
+	Name         string
+	Description  string
+}
+
+type InsertCollectionEventArgs struct {
+	CollectionID         uint64
+	RepositoryID         uint64
+	Type                 myawesomelistv1.CollectionEventType
+	ProjectName          string
+	CategoryName         string
+	PreviousCategoryName string
+	Description          string
+	PreviousDescription  string
+}
+
+type ListCollectionChangesArgs struct {
+	Repo  *myawesomelistv1.Repository
+	Start *time.Time
+	End   *time.Time
+	Limit uint32
+}
+
+// CollectionHistoryCursor is the last README revision of a collection whose entries were recorded.
+type CollectionHistoryCursor struct {
+	SHA         string
+	CommittedAt time.Time
+}
+
+type UpsertCollectionHistoryCursorArgs struct {
+	CollectionID uint64
+	SHA          string
+	CommittedAt  time.Time
+}
+
+// RecordCollectionEntriesSeenArgs lists the repositories listed in a README revision.
+type RecordCollectionEntriesSeenArgs struct {
+	CollectionID uint64
+	SHA          string
+	CommittedAt  time.Time
+	Repos        []*myawesomelistv1.Repository
+}
+
 type ListCollectionsArgs struct {
 	Repos []*myawesomelistv1.Repository
 }
 
-type StaledProjectEmbeddingResult struct {
+type ListCollectionsPageArgs struct {
+	Repos     []*myawesomelistv1.Repository
+	PageSize  uint32
+	PageToken string
+}
+
+type ListCategoriesPageArgs struct {
+	Repo           *myawesomelistv1.Repository
+	IncludeRemoved bool
+	PageSize       uint32
+	PageToken      string
+}
+
+type ListCategoriesPageResult struct {
+	Categories    []*myawesomelistv1.Category
+	NextPageToken string
+	TotalSize     uint32
+}
+
+type ListProjectsPageArgs struct {
+	Repo           *myawesomelistv1.Repository
+	CategoryName   string
+	OrderBy        myawesomelistv1.ProjectOrderBy
+	IncludeRemoved bool
+	PageSize       uint32
+	PageToken      string
+}
+
+type ListProjectsPageResult struct {
+	Projects      []*myawesomelistv1.Project
+	NextPageToken string
+	TotalSize     uint32
+}
+
+type ListCollectionsPageResult struct {
+	Collections   []*myawesomelistv1.Collection
+	NextPageToken string
+	TotalSize     uint32
+}
+
+type ProjectEmbeddingInputResult struct {
 	ID           uint64
 	CategoryID   uint64
 	RepositoryID uint64
@@ -63,15 +154,107 @@ type StaledProjectEmbeddingResult struct {
 	Hostname     string
 	Owner        string
 	Repo         string
+	Category     string
+	Collection   string
+	Language     string
+	Topics       []string
+	Readme       string
+	InputHash    *string
+	Expired      bool
 }
 
 type UpsertProjectEmbeddingArgs struct {
 	ProjectID uint64
+	Model     string
+	InputHash string
 	Vec       []float32
 }
 
-type ListStaledProjectEmbeddingsArgs struct {
-	TTL time.Duration
+type AdoptLegacyProjectEmbeddingsArgs struct {
+	Model      string
+	Dimensions int
+}
+
+type DeleteProjectEmbeddingsArgs struct {
+	KeepModel string
+}
+
+type GetQueryEmbeddingArgs struct {
+	Model string
+	Query string
+}
+
+type UpsertQueryEmbeddingArgs struct {
+	Model string
+	Query string
+	Vec   []float32
+}
+
+type DeleteQueryEmbeddingsArgs struct {
+	KeepModel string
+	UnusedFor time.Duration
+}
+
+type RebuildEmbeddingIndexesArgs struct {
+	Method         VectorIndex
+	M              int
+	EfConstruction int
+	Lists          int
+}
+
+type EmbeddingIndexResult struct {
+	Name       string
+	Model      string
+	Dimensions int
+	Count      int64
+	Method     VectorIndex
+	Options    string
+}
+
+type SearchProjectsArgs struct {
+	Query           string
+	Embeddings      [][]float32
+	EmbeddingModel  string
+	SemanticWeight  *float64
+	LexicalWeight   *float64
+	Limit           uint32
+	PageToken       string
+	Repos           []*myawesomelistv1.Repository
+	IncludeRemoved  bool
+	Language        string
+	Category        string
+	MinStars        *uint32
+	MaxStars        *uint32
+	PushedAfter     *time.Time
+	License         string
+	Host            string
+	ExcludeArchived bool
+}
+
+type SearchProjectsResult struct {
+	Projects      []*myawesomelistv1.Project
+	NextPageToken string
+	// TotalSize is the number of ranked projects, at most searchProjectsCandidates per ranking.
+	TotalSize uint32
+}
+
+type FindSimilarProjectsArgs struct {
+	ProjectID            uint64
+	Model                string
+	Repo                 *myawesomelistv1.Repository
+	OtherCollectionsOnly bool
+	Languages            []string
+	Limit                uint32
+}
+
+type GetCollectionArgs struct {
+	Repo           *myawesomelistv1.Repository
+	IncludeRemoved bool
+}
+
+type ListProjectEmbeddingInputsArgs struct {
+	Model string
+	TTL   time.Duration
 }
 
 type UpsertProjectMetadataArgs struct {
@@ -79,14 +262,138 @@ type UpsertProjectMetadataArgs struct {
 	Readme       string
 }
 
+type ListStaledProjectMetadataArgs struct {
+	Hostname string
+	TTL      time.Duration
+}
+
+type StaledProjectMetadataResult struct {
+	RepositoryID uint64
+	Hostname     string
+	Owner        string
+	Repo         string
+}
+
+type ListStaledProjectRegistryStatsArgs struct {
+	Registry  string
+	Ecosystem string
+	Languages []string
+	TTL       time.Duration
+}
+
+type StaledProjectRegistryStatsResult struct {
+	RepositoryID uint64
+	Hostname     string
+	Owner        string
+	Repo         string
+	// ManifestPackage is the package declared by the manifest of the repository in the ecosystem of
+	// the registry, empty when unknown.
+	ManifestPackage string
+}
+
+type UpsertProjectRegistryStatsArgs struct {
+	RepositoryID    uint64
+	Registry        string
+	Package         string
+	Downloads       *uint64
+	DownloadsPeriod string
+	LatestVersion   string
+	VersionsCount   *uint32
+}
+
+type ListStaledProjectManifestsArgs struct {
+	Ecosystem string
+	Languages []string
+	TTL       time.Duration
+}
+
+type StaledProjectManifestResult struct {
+	RepositoryID uint64
+	Hostname     string
+	Owner        string
+	Repo         string
+}
+
+type UpsertProjectManifestArgs struct {
+	RepositoryID uint64
+	Ecosystem    string
+	Package      string
+	Dependencies []string
+}
+
 type GetProjectStatsArgs struct {
 	Repo myawesomelistv1.Repository
 }
 
 type UpsertProjectStatsArgs struct {
-	RepositoryID    uint64
-	StargazersCount *uint32
-	OpenIssueCount  *uint32
+	RepositoryID        uint64
+	StargazersCount     *uint32
+	OpenIssueCount      *uint32
+	ForksCount          *uint32
+	SubscribersCount    *uint32
+	License             string
+	Topics              []string
+	Language            string
+	DefaultBranch       string
+	CreatedAt           *time.Time
+	PushedAt            *time.Time
+	Archived            bool
+	ContributorsCount   *uint32
+	TopContributorShare *float64
+	BusFactor           *uint32
+	// RecordHistory also appends the counts to the stats history, for freshly fetched stats.
+	RecordHistory bool
+}
+
+type UpsertProjectReleaseArgs struct {
+	RepositoryID uint64
+	TagName      string
+	Name         string
+	PublishedAt  *time.Time
+	Prerelease   bool
+	URL          string
+	CadenceDays  *float64
+}
+
+type ListProjectStatsHistoryArgs struct {
+	Repo     *myawesomelistv1.Repository
+	Start    *time.Time
+	End      *time.Time
+	Interval myawesomelistv1.StatsInterval
+}
+
+type ListTrendingProjectsArgs struct {
+	Window       myawesomelistv1.TrendingWindow
+	Repo         *myawesomelistv1.Repository
+	CategoryName string
+	Language     string
+	Limit        uint32
+}
+
+type ListStaledProjectHealthArgs struct {
+	TTL time.Duration
+}
+
+type StaledProjectHealthResult struct {
+	RepositoryID       uint64
+	StargazersCount    *uint32
+	OpenIssueCount     *uint32
+	PushedAt           *time.Time
+	Archived           bool
+	Status             string
+	ReleasePublishedAt *time.Time
+	ReleaseCadenceDays *float64
+	ContributorsCount  *uint32
+}
+
+type UpdateProjectHealthScoreArgs struct {
+	RepositoryID uint64
+	Score        float64
+}
+
+type UpdateRepositoryStatusArgs struct {
+	RepositoryID uint64
+	Status       myawesomelistv1.RepositoryStatus
 }
 
 var UpsertRepositoryQuery = strings.Join([]string{
@@ -109,7 +416,7 @@ var UpsertCategoryQuery = strings.Join([]string{
 	"INSERT INTO categories (collection_id, name)",
 	"VALUES ($1, $2)",
 	"ON CONFLICT (collection_id, name)",
-	"DO UPDATE SET updated_at = NOW()",
+	"DO UPDATE SET updated_at = NOW(), removed_at = NULL",
 	"RETURNING id",
 }, " ")
 
@@ -117,22 +424,164 @@ var UpsertProjectQuery = strings.Join([]string{
 	"INSERT INTO projects (category_id, repository_id, name, description)",
 	"VALUES ($1, $2, $3, $4)",
 	"ON CONFLICT (category_id, repository_id)",
-	"DO UPDATE SET name = EXCLUDED.name, description = EXCLUDED.description, updated_at = NOW()",
+	"DO UPDATE SET name = EXCLUDED.name, description = EXCLUDED.description, updated_at = NOW(),",
+	"removed_at = NULL",
 	"RETURNING id",
 }, " ")
 
+var CollectionEntriesQuery = strings.Join([]string{
+	"SELECT p.id, c.name, p.repository_id, r.hostname, r.owner, r.repo, p.name, COALESCE(p.description, '')",
+	"FROM projects p",
+	"JOIN categories c ON c.id = p.category_id",
+	"JOIN repositories r ON r.id = p.repository_id",
+	"WHERE c.collection_id = $1 AND p.removed_at IS NULL",
+}, " ")
+
+var InsertCollectionEventQuery = strings.Join([]string{
+	"INSERT INTO collection_events",
+	"(collection_id, repository_id, type, project_name, category_name, previous_category_name, description, previous_description)",
+	"VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), NULLIF($7, ''), NULLIF($8, ''))",
+}, " ")
+
+var MarkProjectsRemovedQuery = strings.Join([]string{
+	"UPDATE projects SET removed_at = NOW()",
+	"WHERE id = ANY($1::bigint[]) AND removed_at IS NULL",
+}, " ")
+
+// MarkCategoriesRemovedQuery marks the categories of a collection missing from the latest ingest as removed.
+var MarkCategoriesRemovedQuery = strings.Join([]string{
+	"UPDATE categories SET removed_at = NOW()",
+	"WHERE collection_id = $1 AND NOT (name = ANY($2::text[])) AND removed_at IS NULL",
+}, " ")
+
+var CollectionIDByRepoIDQuery = "SELECT id FROM collections WHERE repository_id = $1"
+
+var CollectionHistoryCursorQuery = strings.Join([]string{
+	"SELECT last_sha, last_committed_at",
+	"FROM collection_history_backfills",
+	"WHERE collection_id = $1",
+}, " ")
+
+var UpsertCollectionHistoryCursorQuery = strings.Join([]string{
+	"INSERT INTO collection_history_backfills (collection_id, last_sha, last_committed_at)",
+	"VALUES ($1, $2, $3)",
+	"ON CONFLICT (collection_id)",
+	"DO UPDATE SET last_sha = EXCLUDED.last_sha, last_committed_at = EXCLUDED.last_committed_at, updated_at = NOW()",
+}, " ")
+
+// RecordCollectionEntriesSeenQuery widens the first and last seen bounds of the repositories listed
+// in a README revision, registering repositories which were dropped before the first ingest.
+// Revisions can be recorded in any order.
+var RecordCollectionEntriesSeenQuery = strings.Join([]string{
+	"WITH input AS (",
+	"SELECT DISTINCT hostname, owner, repo",
+	"FROM unnest($2::text[], $3::text[], $4::text[]) AS t(hostname, owner, repo)",
+	"), inserted AS (",
+	"INSERT INTO repositories (hostname, owner, repo)",
+	"SELECT hostname, owner, repo FROM input",
+	"ON CONFLICT (hostname, owner, repo) DO NOTHING",
+	"RETURNING id",
+	"), ids AS (",
+	"SELECT id FROM inserted",
+	"UNION",
+	"SELECT r.id FROM repositories r JOIN input i",
+	"ON r.hostname = i.hostname AND r.owner = i.owner AND r.repo = i.repo",
+	")",
+	"INSERT INTO collection_entry_history AS h",
+	"(collection_id, repository_id, first_seen_at, first_seen_sha, last_seen_at, last_seen_sha)",
+	"SELECT $1, id, $5, $6, $5, $6 FROM ids",
+	"ON CONFLICT (collection_id, repository_id) DO UPDATE SET",
+	"first_seen_at = LEAST(h.first_seen_at, EXCLUDED.first_seen_at),",
+	"first_seen_sha = CASE WHEN EXCLUDED.first_seen_at < h.first_seen_at",
+	"THEN EXCLUDED.first_seen_sha ELSE h.first_seen_sha END,",
+	"last_seen_at = GREATEST(h.last_seen_at, EXCLUDED.last_seen_at),",
+	"last_seen_sha = CASE WHEN EXCLUDED.last_seen_at > h.last_seen_at",
+	"THEN EXCLUDED.last_seen_sha ELSE h.last_seen_sha END",
+}, " ")
+
+var CollectionChangesQuery = strings.Join([]string{
+	"SELECT e.id, e.type, cr.hostname, cr.owner, cr.repo, r.hostname, r.owner, r.repo,",
+	"e.project_name, e.category_name, COALESCE(e.previous_category_name, ''),",
+	"COALESCE(e.description, ''), COALESCE(e.previous_description, ''), e.created_at",
+	"FROM collection_events e",
+	"JOIN collections col ON col.id = e.collection_id",
+	"JOIN repositories cr ON cr.id = col.repository_id",
+	"JOIN repositories r ON r.id = e.repository_id",
+	"WHERE ($1::bigint IS NULL OR col.repository_id = $1::bigint)",
+	"AND ($2::timestamptz IS NULL OR e.created_at >= $2::timestamptz)",
+	"AND ($3::timestamptz IS NULL OR e.created_at < $3::timestamptz)",
+	"ORDER BY e.created_at DESC, e.id DESC",
+	"LIMIT $4",
+}, " ")
+
 var UpsertProjectEmbeddingQuery = strings.Join([]string{
-	"INSERT INTO project_embeddings (project_id, embedding)",
-	"VALUES ($1, $2)",
-	"ON CONFLICT (project_id)",
-	"DO UPDATE SET embedding = EXCLUDED.embedding, updated_at = NOW()",
+	"INSERT INTO project_embeddings (project_id, model, dimensions, embedding, input_hash)",
+	"VALUES ($1, $2, vector_dims($3::vector), $3, NULLIF($4, ''))",
+	"ON CONFLICT (project_id, model)",
+	"DO UPDATE SET embedding = EXCLUDED.embedding, dimensions = EXCLUDED.dimensions,",
+	"input_hash = EXCLUDED.input_hash, updated_at = NOW()",
 }, " ")
 
-var UpsertProjectStatsQuery = strings.Join([]string{
-	"INSERT INTO project_stats (repository_id, stargazers_count, open_issue_count)",
+// AdoptLegacyProjectEmbeddingsQuery records the model of the embeddings stored before models were
+// recorded, skipping projects which already have an embedding from that model.
+var AdoptLegacyProjectEmbeddingsQuery = strings.Join([]string{
+	"UPDATE project_embeddings pe SET model = $1",
+	"WHERE pe.model = '' AND pe.dimensions = $2",
+	"AND NOT EXISTS (SELECT 1 FROM project_embeddings o WHERE o.project_id = pe.project_id AND o.model = $1)",
+}, " ")
+
+var DeleteProjectEmbeddingsQuery = strings.Join([]string{
+	"DELETE FROM project_embeddings WHERE model <> $1",
+}, " ")
+
+// QueryEmbeddingQuery returns a stored query embedding and marks it as used.
+var QueryEmbeddingQuery = strings.Join([]string{
+	"UPDATE query_embeddings SET used_at = NOW()",
+	"WHERE model = $1 AND query = $2",
+	"RETURNING embedding",
+}, " ")
+
+var UpsertQueryEmbeddingQuery = strings.Join([]string{
+	"INSERT INTO query_embeddings (model, query, embedding)",
 	"VALUES ($1, $2, $3)",
+	"ON CONFLICT (model, query)",
+	"DO UPDATE SET embedding = EXCLUDED.embedding, used_at = NOW()",
+}, " ")
+
+var DeleteQueryEmbeddingsQuery = strings.Join([]string{
+	"DELETE FROM query_embeddings WHERE model <> $1",
+	"OR ($2::double precision >= 0 AND EXTRACT(EPOCH FROM NOW() - used_at) > $2::double precision)",
+}, " ")
+
+var UpsertProjectStatsQuery = strings.Join([]string{
+	"INSERT INTO project_stats (repository_id, stargazers_count, open_issue_count,",
+	"forks_count, subscribers_count, license, topics, language, default_branch,",
+	"repository_created_at, pushed_at, archived, contributors_count, top_contributor_share, bus_factor)",
+	"VALUES ($1, $2, $3, $4, $5, NULLIF($6, ''), COALESCE($7::text[], '{}'), NULLIF($8, ''), NULLIF($9, ''), $10, $11, $12,",
+	"$13, $14, $15)",
 	"ON CONFLICT (repository_id)",
-	"DO UPDATE SET stargazers_count = EXCLUDED.stargazers_count, open_issue_count = EXCLUDED.open_issue_count, updated_at = NOW()",
+	"DO UPDATE SET stargazers_count = EXCLUDED.stargazers_count, open_issue_count = EXCLUDED.open_issue_count,",
+	"forks_count = EXCLUDED.forks_count, subscribers_count = EXCLUDED.subscribers_count,",
+	"license = EXCLUDED.license, topics = EXCLUDED.topics, language = EXCLUDED.language,",
+	"default_branch = EXCLUDED.default_branch, repository_created_at = EXCLUDED.repository_created_at,",
+	"pushed_at = EXCLUDED.pushed_at, archived = EXCLUDED.archived,",
+	"contributors_count = EXCLUDED.contributors_count, top_contributor_share = EXCLUDED.top_contributor_share,",
+	"bus_factor = EXCLUDED.bus_factor, updated_at = NOW()",
+}, " ")
+
+var InsertProjectStatsHistoryQuery = strings.Join([]string{
+	"INSERT INTO project_stats_history",
+	"(repository_id, stargazers_count, open_issue_count, forks_count, subscribers_count)",
+	"VALUES ($1, $2, $3, $4, $5)",
+}, " ")
+
+var UpsertProjectReleaseQuery = strings.Join([]string{
+	"INSERT INTO project_releases (repository_id, tag_name, name, published_at, prerelease, html_url, cadence_days)",
+	"VALUES ($1, NULLIF($2, ''), NULLIF($3, ''), $4, $5, NULLIF($6, ''), $7)",
+	"ON CONFLICT (repository_id)",
+	"DO UPDATE SET tag_name = EXCLUDED.tag_name, name = EXCLUDED.name, published_at = EXCLUDED.published_at,",
+	"prerelease = EXCLUDED.prerelease, html_url = EXCLUDED.html_url, cadence_days = EXCLUDED.cadence_days,",
+	"updated_at = NOW()",
 }, " ")
 
 var UpsertProjectMetadataQuery = strings.Join([]string{
@@ -142,6 +591,143 @@ var UpsertProjectMetadataQuery = strings.Join([]string{
 	"DO UPDATE SET readme = EXCLUDED.readme, updated_at = NOW()",
 }, " ")
 
+// ProjectsStaledHealthQuery selects the health signals of repositories whose score is missing,
+// older than their stats or release, or older than the TTL in seconds (negative disables the TTL).
+var ProjectsStaledHealthQuery = strings.Join([]string{
+	"SELECT ps.repository_id, ps.stargazers_count, ps.open_issue_count, ps.pushed_at, ps.archived,",
+	"r.status, pr.published_at, pr.cadence_days, ps.contributors_count",
+	"FROM project_stats ps",
+	"JOIN repositories r ON r.id = ps.repository_id",
+	"LEFT JOIN project_releases pr ON pr.repository_id = ps.repository_id",
+	"WHERE ps.health_scored_at IS NULL",
+	"OR ps.health_scored_at < ps.updated_at",
+	"OR ps.health_scored_at < pr.updated_at",
+	"OR ($1::double precision >= 0 AND EXTRACT(EPOCH FROM NOW() - ps.health_scored_at) > $1::double precision)",
+}, " ")
+
+var UpdateProjectHealthScoreQuery = strings.Join([]string{
+	"UPDATE project_stats",
+	"SET health_score = $2, health_scored_at = NOW()",
+	"WHERE repository_id = $1",
+}, " ")
+
+// ProjectsStaledMetadataQuery selects listed repositories of a host whose README was never fetched
+// or is older than the TTL in seconds (negative disables the TTL), skipping unavailable repositories.
+var ProjectsStaledMetadataQuery = strings.Join([]string{
+	"SELECT r.id, r.hostname, r.owner, r.repo",
+	"FROM repositories r",
+	"LEFT JOIN project_metadata pm ON pm.repository_id = r.id",
+	"WHERE r.hostname = $1",
+	"AND r.status NOT IN ('not_found', 'blocked', 'disabled')",
+	"AND EXISTS (SELECT 1 FROM projects p WHERE p.repository_id = r.id AND p.removed_at IS NULL)",
+	"AND (pm.updated_at IS NULL",
+	"OR ($2::double precision >= 0 AND EXTRACT(EPOCH FROM NOW() - pm.updated_at) > $2::double precision))",
+	"ORDER BY pm.updated_at NULLS FIRST, r.id",
+}, " ")
+
+// ProjectsStaledRegistryStatsQuery selects listed available repositories whose primary language is one
+// of the given languages and whose stats on the registry are missing or older than the TTL in seconds
+// (negative disables the TTL), with the package declared by their manifest of the given ecosystem.
+var ProjectsStaledRegistryStatsQuery = strings.Join([]string{
+	"SELECT r.id, r.hostname, r.owner, r.repo, COALESCE(pm.package, '')",
+	"FROM repositories r",
+	"JOIN project_stats ps ON ps.repository_id = r.id",
+	"LEFT JOIN project_registry_stats prs ON prs.repository_id = r.id AND prs.registry = $1",
+	"LEFT JOIN project_manifests pm ON pm.repository_id = r.id AND pm.ecosystem = $4",
+	"WHERE LOWER(ps.language) = ANY($2::text[])",
+	"AND r.status NOT IN ('not_found', 'blocked', 'disabled')",
+	"AND EXISTS (SELECT 1 FROM projects p WHERE p.repository_id = r.id AND p.removed_at IS NULL)",
+	"AND (prs.updated_at IS NULL",
+	"OR ($3::double precision >= 0 AND EXTRACT(EPOCH FROM NOW() - prs.updated_at) > $3::double precision))",
+	"ORDER BY prs.updated_at NULLS FIRST, r.id",
+}, " ")
+
+var UpsertProjectRegistryStatsQuery = strings.Join([]string{
+	"INSERT INTO project_registry_stats",
+	"(repository_id, registry, package, downloads, downloads_period, latest_version, versions_count)",
+	"VALUES ($1, $2, NULLIF($3, ''), $4, NULLIF($5, ''), NULLIF($6, ''), $7)",
+	"ON CONFLICT (repository_id, registry)",
+	"DO UPDATE SET package = EXCLUDED.package, downloads = EXCLUDED.downloads,",
+	"downloads_period = EXCLUDED.downloads_period, latest_version = EXCLUDED.latest_version,",
+	"versions_count = EXCLUDED.versions_count, updated_at = NOW()",
+}, " ")
+
+// ProjectRegistryStatsByRepoIDQuery selects the registry stats of a repository that has a package.
+var ProjectRegistryStatsByRepoIDQuery = strings.Join([]string{
+	"SELECT registry, package, downloads, COALESCE(downloads_period, ''), COALESCE(latest_version, ''),",
+	"versions_count, updated_at",
+	"FROM project_registry_stats",
+	"WHERE repository_id = $1 AND package IS NOT NULL",
+	"ORDER BY registry",
+}, " ")
+
+// ProjectsStaledManifestsQuery selects listed available repositories whose primary language is one
+// of the given languages and whose manifest of the ecosystem is missing or older than the TTL in
+// seconds (negative disables the TTL).
+var ProjectsStaledManifestsQuery = strings.Join([]string{
+	"SELECT r.id, r.hostname, r.owner, r.repo",
+	"FROM repositories r",
+	"JOIN project_stats ps ON ps.repository_id = r.id",
+	"LEFT JOIN project_manifests pm ON pm.repository_id = r.id AND pm.ecosystem = $1",
+	"WHERE LOWER(ps.language) = ANY($2::text[])",
+	"AND r.status NOT IN ('not_found', 'blocked', 'disabled')",
+	"AND EXISTS (SELECT 1 FROM projects p WHERE p.repository_id = r.id AND p.removed_at IS NULL)",
+	"AND (pm.updated_at IS NULL",
+	"OR ($3::double precision >= 0 AND EXTRACT(EPOCH FROM NOW() - pm.updated_at) > $3::double precision))",
+	"ORDER BY pm.updated_at NULLS FIRST, r.id",
+}, " ")
+
+var UpsertProjectManifestQuery = strings.Join([]string{
+	"INSERT INTO project_manifests (repository_id, ecosystem, package, dependencies)",
+	"VALUES ($1, $2, NULLIF($3, ''), COALESCE($4::text[], '{}'))",
+	"ON CONFLICT (repository_id, ecosystem)",
+	"DO UPDATE SET package = EXCLUDED.package, dependencies = EXCLUDED.dependencies, updated_at = NOW()",
+}, " ")
+
+var DeleteProjectDependenciesQuery = "DELETE FROM project_dependencies"
+
+// InsertProjectDependenciesQuery rebuilds dependency edges between catalogued repositories.
+// Packages are known from parsed manifests and from registry stats, whose goproxy registry
+// belongs to the go ecosystem.
+var InsertProjectDependenciesQuery = strings.Join([]string{
+	"WITH packages AS (",
+	"SELECT repository_id, ecosystem, LOWER(package) AS name",
+	"FROM project_manifests WHERE package IS NOT NULL",
+	"UNION",
+	"SELECT repository_id, CASE registry WHEN 'goproxy' THEN 'go' ELSE registry END, LOWER(package)",
+	"FROM project_registry_stats WHERE package IS NOT NULL",
+	")",
+	"INSERT INTO project_dependencies (repository_id, dependency_repository_id, ecosystem, package)",
+	"SELECT m.repository_id, pk.repository_id, m.ecosystem, d.name",
+	"FROM project_manifests m",
+	"CROSS JOIN LATERAL unnest(m.dependencies) AS d(name)",
+	"JOIN packages pk ON pk.ecosystem = m.ecosystem AND pk.name = LOWER(d.name)",
+	"WHERE pk.repository_id <> m.repository_id",
+	"ON CONFLICT (repository_id, dependency_repository_id, ecosystem) DO NOTHING",
+}, " ")
+
+var DependenciesByRepoIDQuery = strings.Join([]string{
+	"SELECT r.hostname, r.owner, r.repo, pd.ecosystem, pd.package",
+	"FROM project_dependencies pd",
+	"JOIN repositories r ON r.id = pd.dependency_repository_id",
+	"WHERE pd.repository_id = $1",
+	"ORDER BY r.hostname, r.owner, r.repo, pd.ecosystem",
+}, " ")
+
+var DependentsByRepoIDQuery = strings.Join([]string{
+	"SELECT r.hostname, r.owner, r.repo, pd.ecosystem, pd.package",
+	"FROM project_dependencies pd",
+	"JOIN repositories r ON r.id = pd.repository_id",
+	"WHERE pd.dependency_repository_id = $1",
+	"ORDER BY r.hostname, r.owner, r.repo, pd.ecosystem",
+}, " ")
+
+var UpdateRepositoryStatusQuery = strings.Join([]string{
+	"UPDATE repositories",
+	"SET status = $2, status_checked_at = NOW()",
+	"WHERE id = $1",
+}, " ")
+
 var RepoIDQuery = strings.Join([]string{
 	"SELECT id FROM repositories",
 	"WHERE hostname=$1 AND owner=$2 AND repo=$3",
@@ -155,30 +741,200 @@ var CollectionByRepoIDQuery = strings.Join([]string{
 }, " ")
 
 var CategoriesByCollectionIDsQuery = strings.Join([]string{
-	"SELECT id, collection_id, name, updated_at",
+	"SELECT id, collection_id, name, updated_at, removed_at",
 	"FROM categories",
 	"WHERE collection_id = ANY($1::bigint[])",
+	"AND ($2::boolean OR removed_at IS NULL)",
 }, " ")
 
 var ProjectsByCategoryIDsQuery = strings.Join([]string{
 	"SELECT p.id, p.category_id, p.repository_id, p.name, p.description, p.updated_at,",
-	"r.hostname, r.owner, r.repo FROM projects p JOIN repositories r ON r.id = p.repository_id",
+	"r.hostname, r.owner, r.repo, r.status,",
+	"pr.tag_name, pr.name, pr.published_at, pr.prerelease, pr.html_url, ps.health_score,",
+	"(SELECT COUNT(DISTINCT pd.repository_id) FROM project_dependencies pd WHERE pd.dependency_repository_id = p.repository_id),",
+	"p.removed_at, COALESCE(h.first_seen_at, p.created_at)",
+	"FROM projects p JOIN repositories r ON r.id = p.repository_id",
+	"JOIN categories c ON c.id = p.category_id",
+	"LEFT JOIN project_releases pr ON pr.repository_id = p.repository_id",
+	"LEFT JOIN project_stats ps ON ps.repository_id = p.repository_id",
+	"LEFT JOIN collection_entry_history h ON h.collection_id = c.collection_id AND h.repository_id = p.repository_id",
 	"WHERE p.category_id = ANY($1::bigint[])",
+	"AND ($2::boolean OR p.removed_at IS NULL)",
+}, " ")
+
+var CategoriesPageQuery = strings.Join([]string{
+	"SELECT c.id, c.collection_id, c.name, c.updated_at, c.removed_at",
+	"FROM categories c",
+	"JOIN collections col ON col.id = c.collection_id",
+	"JOIN repositories r ON r.id = col.repository_id",
+	"WHERE r.hostname = $1 AND r.owner = $2 AND r.repo = $3",
+	"AND ($4::boolean OR c.removed_at IS NULL)",
+	"AND c.id > $5",
+	"ORDER BY c.id",
+	"LIMIT $6",
+}, " ")
+
+var CountCategoriesQuery = strings.Join([]string{
+	"SELECT COUNT(*)",
+	"FROM categories c",
+	"JOIN collections col ON col.id = c.collection_id",
+	"JOIN repositories r ON r.id = col.repository_id",
+	"WHERE r.hostname = $1 AND r.owner = $2 AND r.repo = $3",
+	"AND ($4::boolean OR c.removed_at IS NULL)",
 }, " ")
 
-var ProjectsStaledEmbeddingsQuery = strings.Join([]string{
+var SimilarProjectsSourceQuery = strings.Join([]string{
+	"SELECT pe.model, AVG(pe.embedding), ARRAY_AGG(DISTINCT p.repository_id), ARRAY_AGG(DISTINCT c.collection_id)",
+	"FROM projects p",
+	"JOIN repositories r ON r.id = p.repository_id",
+	"JOIN categories c ON c.id = p.category_id",
+	"JOIN project_embeddings pe ON pe.project_id = p.id",
+	"WHERE (($1::bigint > 0 AND p.id = $1)",
+	"OR ($1::bigint = 0 AND r.hostname = $2 AND r.owner = $3 AND r.repo = $4 AND p.removed_at IS NULL))",
+	"AND ($5 = '' OR pe.model = $5)",
+	// Without a requested model, the model embedding most of the source entries is used.
+	"GROUP BY pe.model",
+	"ORDER BY COUNT(*) DESC, pe.model",
+	"LIMIT 1",
+}, " ")
+
+// similarProjectsCandidates is the minimum number of nearest embeddings drawn from the index of
+// the model before excluding the source repositories, filtering and deduplicating repositories.
+const similarProjectsCandidates = 100
+
+var similarProjectsQueryTmpl = template.Must(
+	template.New("similarProjects").Parse(strings.Join([]string{
+		"SELECT p.id, p.name, p.description, p.updated_at, r.hostname, r.owner, r.repo, r.status,",
+		"pr.tag_name, pr.name, pr.published_at, pr.prerelease, pr.html_url, ps.health_score,",
+		"(SELECT COUNT(DISTINCT pd.repository_id) FROM project_dependencies pd WHERE pd.dependency_repository_id = p.repository_id),",
+		"p.removed_at, n.distance, n.language",
+		"FROM (",
+		// A repository listed in several categories or collections is returned once.
+		"SELECT DISTINCT ON (p.repository_id) p.id, e.distance, col.language",
+		"FROM (",
+		// The nearest embeddings are selected first, with the model as a literal, so that the
+		// index of the model is used.
+		"SELECT pe.project_id, {{.Distance}} AS distance FROM project_embeddings pe",
+		"WHERE pe.model = {{.Model}}",
+		"ORDER BY distance LIMIT $7",
+		") e",
+		"JOIN projects p ON p.id = e.project_id",
+		"JOIN categories c ON c.id = p.category_id",
+		"JOIN collections col ON col.id = c.collection_id",
+		"WHERE p.removed_at IS NULL",
+		"AND NOT (p.repository_id = ANY($2::bigint[]))",
+		"AND NOT ($3::boolean AND c.collection_id = ANY($4::bigint[]))",
+		"AND (cardinality($5::text[]) = 0 OR lower(col.language) = ANY($5::text[]))",
+		"ORDER BY p.repository_id, e.distance",
+		") n",
+		"JOIN projects p ON p.id = n.id",
+		"JOIN repositories r ON r.id = p.repository_id",
+		"LEFT JOIN project_releases pr ON pr.repository_id = p.repository_id",
+		"LEFT JOIN project_stats ps ON ps.repository_id = p.repository_id",
+		"ORDER BY n.distance, p.id",
+		"LIMIT $6",
+	}, " ")),
+)
+
+// RenderSimilarProjectsQuery renders the query of the projects nearest to the $1 embedding of
+// model, which has dims dimensions, compared with distance, among its $7 nearest embeddings.
+func RenderSimilarProjectsQuery(model string, dims int, distance Distance) (string, error) {
+	var buf bytes.Buffer
+	if err := similarProjectsQueryTmpl.Execute(&buf, map[string]interface{}{
+		"Distance": distanceExpr(distance, "pe.embedding", "$1", dims),
+		"Model":    quoteLiteral(model),
+	}); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// ProjectEmbeddingInputsQuery lists the fields embedded for every listed project, with the input
+// hash of its embedding from the $2 model and whether that embedding is older than $1 seconds.
+// READMEs are truncated as only their first paragraphs are embedded.
+var ProjectEmbeddingInputsQuery = strings.Join([]string{
 	"SELECT p.id, p.category_id, p.repository_id, p.name, p.description, p.updated_at,",
-	"r.hostname, r.owner, r.repo FROM projects p",
+	"r.hostname, r.owner, r.repo, c.name, cr.repo, col.language, COALESCE(ps.topics, '{}'),",
+	"COALESCE(left(pm.readme, 16384), ''), pe.input_hash,",
+	"(pe.updated_at IS NOT NULL AND $1::double precision >= 0",
+	"AND EXTRACT(EPOCH FROM NOW() - pe.updated_at) > $1::double precision)",
+	"FROM projects p",
 	"JOIN repositories r ON r.id = p.repository_id",
-	"LEFT JOIN project_embeddings pe ON pe.project_id = p.id",
-	"WHERE pe.updated_at IS NULL",
-	"OR ($1::double precision >= 0 AND EXTRACT(EPOCH FROM NOW() - pe.updated_at) > $1::double precision)",
+	"JOIN categories c ON c.id = p.category_id",
+	"JOIN collections col ON col.id = c.collection_id",
+	"JOIN repositories cr ON cr.id = col.repository_id",
+	"LEFT JOIN project_stats ps ON ps.repository_id = p.repository_id",
+	"LEFT JOIN project_metadata pm ON pm.repository_id = p.repository_id",
+	"LEFT JOIN project_embeddings pe ON pe.project_id = p.id AND pe.model = $2",
+	"WHERE p.removed_at IS NULL",
+	"ORDER BY p.id",
 }, " ")
 
 var ProjectStatsByRepoIDQuery = strings.Join([]string{
-	"SELECT id, repository_id, stargazers_count, open_issue_count, updated_at",
-	"FROM project_stats",
-	"WHERE repository_id=$1",
+	"SELECT ps.id, ps.repository_id, ps.stargazers_count, ps.open_issue_count, ps.updated_at,",
+	"r.status, r.status_checked_at, ps.forks_count, ps.subscribers_count, ps.license, ps.topics,",
+	"ps.language, ps.default_branch, ps.repository_created_at, ps.pushed_at, ps.archived,",
+	"pr.tag_name, pr.name, pr.published_at, pr.prerelease, pr.html_url, pr.cadence_days, ps.health_score,",
+	"ps.contributors_count, ps.top_contributor_share, ps.bus_factor",
+	"FROM project_stats ps JOIN repositories r ON r.id = ps.repository_id",
+	"LEFT JOIN project_releases pr ON pr.repository_id = ps.repository_id",
+	"WHERE ps.repository_id=$1",
+}, " ")
+
+// ProjectStatsHistoryQuery selects the stats time series of a repository between optional bounds.
+// When a date_trunc field is given, only the last point of each bucket is kept and stamped with the bucket start.
+var ProjectStatsHistoryQuery = strings.Join([]string{
+	"SELECT DISTINCT ON (bucket) bucket, stargazers_count, open_issue_count, forks_count, subscribers_count",
+	"FROM (",
+	"SELECT COALESCE(date_trunc($4::text, recorded_at), recorded_at) AS bucket, recorded_at,",
+	"stargazers_count, open_issue_count, forks_count, subscribers_count",
+	"FROM project_stats_history",
+	"WHERE repository_id = $1",
+	"AND ($2::timestamptz IS NULL OR recorded_at >= $2::timestamptz)",
+	"AND ($3::timestamptz IS NULL OR recorded_at < $3::timestamptz)",
+	") h",
+	"ORDER BY bucket, recorded_at DESC",
+}, " ")
+
+// TrendingProjectsQuery ranks projects by star gain over a window, damped by the starting star count.
+// The baseline is the last snapshot before the window starts, or the first one inside it.
+// Scope filters on collection repository id, category name and collection language are optional.
+var TrendingProjectsQuery = strings.Join([]string{
+	"WITH scoped AS (",
+	"SELECT DISTINCT ON (p.repository_id) p.id, p.repository_id, p.name, p.description, p.updated_at",
+	"FROM projects p",
+	"JOIN categories cat ON cat.id = p.category_id",
+	"JOIN collections col ON col.id = cat.collection_id",
+	"WHERE p.removed_at IS NULL",
+	"AND ($2::bigint IS NULL OR col.repository_id = $2::bigint)",
+	"AND ($3::text IS NULL OR cat.name = $3::text)",
+	"AND ($4::text IS NULL OR LOWER(col.language) = LOWER($4::text))",
+	"ORDER BY p.repository_id, p.id",
+	"), latest AS (",
+	"SELECT DISTINCT ON (h.repository_id) h.repository_id, h.stargazers_count",
+	"FROM project_stats_history h JOIN scoped s ON s.repository_id = h.repository_id",
+	"WHERE h.stargazers_count IS NOT NULL",
+	"ORDER BY h.repository_id, h.recorded_at DESC",
+	"), baseline AS (",
+	"SELECT DISTINCT ON (h.repository_id) h.repository_id, h.stargazers_count",
+	"FROM project_stats_history h JOIN scoped s ON s.repository_id = h.repository_id",
+	"WHERE h.stargazers_count IS NOT NULL",
+	"ORDER BY h.repository_id, GREATEST(h.recorded_at, NOW() - $1::interval), h.recorded_at DESC",
+	")",
+	"SELECT s.id, s.name, s.description, s.updated_at, r.hostname, r.owner, r.repo, r.status,",
+	"pr.tag_name, pr.name, pr.published_at, pr.prerelease, pr.html_url, ps.health_score,",
+	"(SELECT COUNT(DISTINCT pd.repository_id) FROM project_dependencies pd WHERE pd.dependency_repository_id = s.repository_id),",
+	"l.stargazers_count, l.stargazers_count - b.stargazers_count AS delta,",
+	"(l.stargazers_count - b.stargazers_count)::double precision / SQRT(GREATEST(b.stargazers_count, 0) + 1) AS score",
+	"FROM scoped s",
+	"JOIN repositories r ON r.id = s.repository_id",
+	"LEFT JOIN project_releases pr ON pr.repository_id = s.repository_id",
+	"LEFT JOIN project_stats ps ON ps.repository_id = s.repository_id",
+	"JOIN latest l ON l.repository_id = s.repository_id",
+	"JOIN baseline b ON b.repository_id = s.repository_id",
+	"WHERE l.stargazers_count > b.stargazers_count",
+	"ORDER BY score DESC, delta DESC, s.id",
+	"LIMIT $5",
 }, " ")
 
 var tmplFuncs = template.FuncMap{
@@ -188,27 +944,204 @@ var tmplFuncs = template.FuncMap{
 
 var listCollectionsQueryTmpl = template.Must(
 	template.New("listCollections").Funcs(tmplFuncs).Parse(strings.Join([]string{
-		"SELECT c.id, c.repository_id, c.language, c.updated_at, r.hostname, r.owner, r.repo",
+		"{{if .Count}}SELECT COUNT(*){{else}}SELECT c.id, c.repository_id, c.language, c.updated_at, r.hostname, r.owner, r.repo{{end}}",
 		"FROM collections c",
 		"JOIN repositories r ON r.id = c.repository_id",
+		"WHERE TRUE",
 		"{{if gt (len .Repos) 0}}",
-		"WHERE {{range $i, $rp := .Repos}}{{if ne $i 0}} OR {{end}}(r.hostname = ${{add (mul $i 3) 1}} AND r.owner = ${{add (mul $i 3) 2}} AND r.repo = ${{add (mul $i 3) 3}}){{end}}",
+		"AND ({{range $i, $rp := .Repos}}{{if ne $i 0}} OR {{end}}(r.hostname = ${{add (mul $i 3) 1}} AND r.owner = ${{add (mul $i 3) 2}} AND r.repo = ${{add (mul $i 3) 3}}){{end}})",
+		"{{end}}",
+		"{{if not .Count}}",
+		"{{if .AfterPlaceholder}} AND c.id > {{.AfterPlaceholder}}{{end}}",
+		"ORDER BY c.id",
+		"{{if .LimitPlaceholder}} LIMIT {{.LimitPlaceholder}}{{end}}",
 		"{{end}}",
 	}, " ")),
 )
 
+// searchProjectsRRFK dampens the weight of top ranks when fusing the lexical and semantic rankings.
+const searchProjectsRRFK = 60
+
+// searchProjectsCandidates is the number of candidates drawn from each ranking before fusion. It is
+// the same for every page so that pages of a search cut the same ranking, which caps a search at
+// this many results per ranking.
+const searchProjectsCandidates = 100
+
 var searchProjectsQueryTmpl = template.Must(
 	template.New("searchProjects").Funcs(tmplFuncs).Parse(strings.Join([]string{
-		"SELECT p.id, p.name, p.description, p.updated_at, r.hostname, r.owner, r.repo",
-		"FROM projects p",
+		"WITH scoped AS (",
+		"SELECT p.id, p.name, p.search_vector, c.name AS category_name FROM projects p",
 		"JOIN repositories r ON r.id = p.repository_id",
-		"JOIN project_embeddings pe ON pe.project_id = p.id",
-		"{{if gt (len .Repos) 0}} WHERE {{range $i, $rp := .Repos}}{{if ne $i 0}} OR {{end}}(r.hostname = ${{add (mul $i 3) 1}} AND r.owner = ${{add (mul $i 3) 2}} AND r.repo = ${{add (mul $i 3) 3}}){{end}}{{end}}",
-		"{{if .OrderPlaceholder}} ORDER BY pe.embedding <-> {{.OrderPlaceholder}}{{end}}",
-		"{{if .LimitPlaceholder}} LIMIT {{.LimitPlaceholder}}{{end}}",
+		"JOIN categories c ON c.id = p.category_id",
+		"JOIN collections col ON col.id = c.collection_id",
+		"LEFT JOIN project_stats ps ON ps.repository_id = p.repository_id",
+		"WHERE {{if .IncludeRemoved}}TRUE{{else}}p.removed_at IS NULL{{end}}",
+		"{{if gt (len .Repos) 0}} AND ({{range $i, $rp := .Repos}}{{if ne $i 0}} OR {{end}}(r.hostname = ${{add (mul $i 3) 1}} AND r.owner = ${{add (mul $i 3) 2}} AND r.repo = ${{add (mul $i 3) 3}}){{end}}){{end}}",
+		"{{if .LanguagePlaceholder}} AND lower(col.language) = lower({{.LanguagePlaceholder}}){{end}}",
+		"{{if .CategoryPlaceholder}} AND lower(c.name) = lower({{.CategoryPlaceholder}}){{end}}",
+		"{{if .MinStarsPlaceholder}} AND ps.stargazers_count >= {{.MinStarsPlaceholder}}{{end}}",
+		"{{if .MaxStarsPlaceholder}} AND ps.stargazers_count <= {{.MaxStarsPlaceholder}}{{end}}",
+		"{{if .PushedAfterPlaceholder}} AND ps.pushed_at > {{.PushedAfterPlaceholder}}{{end}}",
+		"{{if .LicensePlaceholder}} AND lower(ps.license) = lower({{.LicensePlaceholder}}){{end}}",
+		"{{if .HostPlaceholder}} AND r.hostname = {{.HostPlaceholder}}{{end}}",
+		"{{if .ExcludeArchived}} AND r.status <> 'archived' AND NOT COALESCE(ps.archived, FALSE){{end}}",
+		")",
+		// The nearest embeddings are selected directly from project_embeddings with the model as a
+		// literal so that the index of the model is used.
+		"{{if .EmbeddingPlaceholder}}, semantic AS (",
+		"SELECT n.id, ROW_NUMBER() OVER (ORDER BY n.distance, n.id) AS rank FROM (",
+		"SELECT pe.project_id AS id, {{.Distance}} AS distance FROM project_embeddings pe",
+		"WHERE pe.model = {{.Model}} AND pe.project_id IN (SELECT id FROM scoped)",
+		"ORDER BY distance LIMIT {{.CandidatesPlaceholder}}",
+		") n",
+		"){{end}}",
+		// Match any query term rather than all of them; the rank favours projects matching more terms.
+		"{{if .QueryPlaceholder}}, q AS (",
+		"SELECT t.text, replace(plainto_tsquery('english', t.text)::text, ' & ', ' | ')::tsquery AS query",
+		"FROM (SELECT {{.QueryPlaceholder}}::text AS text) t",
+		"), matches AS (",
+		"SELECT s.id,",
+		"ts_rank_cd(s.search_vector || setweight(to_tsvector('english', s.category_name), 'C'), q.query, 32)",
+		"+ word_similarity(q.text, s.name) AS score",
+		"FROM scoped s, q",
+		// Projects are matched on projects rather than scoped so that the full-text and trigram
+		// indexes are used.
+		"WHERE to_tsvector('english', s.category_name) @@ q.query OR s.id IN (",
+		"SELECT p.id FROM projects p",
+		"WHERE p.search_vector @@ (SELECT query FROM q) OR (SELECT text FROM q) <% p.name",
+		")",
+		"), lexical AS (",
+		"SELECT id, ROW_NUMBER() OVER (ORDER BY score DESC, id) AS rank FROM matches",
+		"ORDER BY rank LIMIT {{.CandidatesPlaceholder}}",
+		"){{end}}",
+		", fused AS (",
+		"SELECT id, SUM(score) AS score FROM (",
+		"{{if .EmbeddingPlaceholder}}SELECT id, {{.SemanticWeightPlaceholder}}::double precision / ({{.K}} + rank) AS score FROM semantic{{end}}",
+		"{{if and .EmbeddingPlaceholder .QueryPlaceholder}} UNION ALL {{end}}",
+		"{{if .QueryPlaceholder}}SELECT id, {{.LexicalWeightPlaceholder}}::double precision / ({{.K}} + rank) AS score FROM lexical{{end}}",
+		"{{if not (or .EmbeddingPlaceholder .QueryPlaceholder)}}SELECT id, 0::double precision AS score FROM scoped{{end}}",
+		") ranked GROUP BY id",
+		")",
+		// Pages are cut by position rather than by score, which is a sum of floats.
+		", ordered AS (",
+		"SELECT id, ROW_NUMBER() OVER (ORDER BY score DESC, id) AS position FROM fused",
+		")",
+		", total AS (SELECT COUNT(*) AS n FROM fused)",
+		"SELECT p.id, p.name, p.description, p.updated_at, r.hostname, r.owner, r.repo, r.status,",
+		"pr.tag_name, pr.name, pr.published_at, pr.prerelease, pr.html_url, ps.health_score,",
+		"(SELECT COUNT(DISTINCT pd.repository_id) FROM project_dependencies pd WHERE pd.dependency_repository_id = p.repository_id),",
+		"p.removed_at, t.n",
+		"FROM ordered f",
+		"CROSS JOIN total t",
+		"JOIN projects p ON p.id = f.id",
+		"JOIN repositories r ON r.id = p.repository_id",
+		"LEFT JOIN project_releases pr ON pr.repository_id = p.repository_id",
+		"LEFT JOIN project_stats ps ON ps.repository_id = p.repository_id",
+		"{{if .AfterPositionPlaceholder}}WHERE f.position > {{.AfterPositionPlaceholder}}{{end}}",
+		"ORDER BY f.position",
+		"LIMIT {{.LimitPlaceholder}}",
 	}, " ")),
 )
 
+var listProjectsQueryTmpl = template.Must(
+	template.New("listProjects").Parse(strings.Join([]string{
+		"WITH listed AS (",
+		"SELECT p.id, p.category_id, p.repository_id, p.name, p.description, p.updated_at,",
+		"r.hostname, r.owner, r.repo, r.status,",
+		"pr.tag_name, pr.name AS release_name, pr.published_at, pr.prerelease, pr.html_url, ps.health_score,",
+		"(SELECT COUNT(DISTINCT pd.repository_id) FROM project_dependencies pd WHERE pd.dependency_repository_id = p.repository_id) AS dependents_count,",
+		"p.removed_at, COALESCE(h.first_seen_at, p.created_at) AS first_seen_at",
+		"FROM projects p JOIN repositories r ON r.id = p.repository_id",
+		"JOIN categories c ON c.id = p.category_id",
+		"JOIN collections col ON col.id = c.collection_id",
+		"JOIN repositories cr ON cr.id = col.repository_id",
+		"LEFT JOIN project_releases pr ON pr.repository_id = p.repository_id",
+		"LEFT JOIN project_stats ps ON ps.repository_id = p.repository_id",
+		"LEFT JOIN collection_entry_history h ON h.collection_id = c.collection_id AND h.repository_id = p.repository_id",
+		"WHERE cr.hostname = $1 AND cr.owner = $2 AND cr.repo = $3 AND c.name = $4",
+		"AND ($5::boolean OR (p.removed_at IS NULL AND c.removed_at IS NULL))",
+		")",
+		"{{if .Count}}SELECT COUNT(*) FROM listed{{else}}",
+		"SELECT id, category_id, repository_id, name, description, updated_at, hostname, owner, repo, status,",
+		"tag_name, release_name, published_at, prerelease, html_url, health_score, dependents_count,",
+		"removed_at, first_seen_at, {{.SortKey}}",
+		"FROM listed",
+		"{{if .AfterKeyPlaceholder}}WHERE ({{.SortKey}} {{if .Desc}}<{{else}}>{{end}} {{.AfterKeyPlaceholder}}",
+		"OR ({{.SortKey}} = {{.AfterKeyPlaceholder}} AND id > {{.AfterIDPlaceholder}})){{end}}",
+		"ORDER BY {{.SortKey}} {{if .Desc}}DESC{{else}}ASC{{end}}, id",
+		"LIMIT {{.LimitPlaceholder}}",
+		"{{end}}",
+	}, " ")),
+)
+
+// projectsSortKind is the type of the sort key of a project ordering.
+type projectsSortKind int
+
+const (
+	projectsSortNumber projectsSortKind = iota
+	projectsSortText
+	projectsSortTime
+)
+
+// projectsSort is the keyset ordering of listed projects; ties are broken by ascending ID.
+type projectsSort struct {
+	key  string
+	kind projectsSortKind
+	desc bool
+}
+
+var projectsSorts = map[myawesomelistv1.ProjectOrderBy]projectsSort{
+	// Projects are inserted in README order, so the ID alone keeps it.
+	myawesomelistv1.ProjectOrderBy_PROJECT_ORDER_BY_UNSPECIFIED:      {"0::double precision", projectsSortNumber, false},
+	myawesomelistv1.ProjectOrderBy_PROJECT_ORDER_BY_NAME:             {"lower(name)", projectsSortText, false},
+	myawesomelistv1.ProjectOrderBy_PROJECT_ORDER_BY_HEALTH_SCORE:     {"COALESCE(health_score, -1)", projectsSortNumber, true},
+	myawesomelistv1.ProjectOrderBy_PROJECT_ORDER_BY_DEPENDENTS_COUNT: {"dependents_count::double precision", projectsSortNumber, true},
+	myawesomelistv1.ProjectOrderBy_PROJECT_ORDER_BY_RECENTLY_ADDED:   {"first_seen_at", projectsSortTime, true},
+}
+
+// RenderListProjectsQuery builds SQL and args for listing the projects of a collection category
+// in the given order, starting after cursor, at most limit of them.
+func RenderListProjectsQuery(args ListProjectsPageArgs, cursor *PageCursor, limit int) (string, []any, error) {
+	sort, ok := projectsSorts[args.OrderBy]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported project order %s", args.OrderBy)
+	}
+	queryArgs := []any{args.Repo.Hostname, args.Repo.Owner, args.Repo.Repo, args.CategoryName, args.IncludeRemoved}
+	placeholder := func(v any) string {
+		queryArgs = append(queryArgs, v)
+		return fmt.Sprintf("$%d", len(queryArgs))
+	}
+	data := map[string]interface{}{"SortKey": sort.key, "Desc": sort.desc}
+	if cursor != nil {
+		var key any
+		switch sort.kind {
+		case projectsSortText:
+			key = ptr.Deref(cursor.Text, "")
+		case projectsSortTime:
+			key = ptr.Deref(cursor.Time, time.Time{})
+		default:
+			key = ptr.Deref(cursor.Number, 0)
+		}
+		data["AfterKeyPlaceholder"] = placeholder(key)
+		data["AfterIDPlaceholder"] = placeholder(cursor.ID)
+	}
+	data["LimitPlaceholder"] = placeholder(limit)
+	var buf bytes.Buffer
+	if err := listProjectsQueryTmpl.Execute(&buf, data); err != nil {
+		return "", nil, err
+	}
+	return buf.String(), queryArgs, nil
+}
+
+// RenderCountProjectsQuery builds SQL and args for counting the projects of a collection category.
+func RenderCountProjectsQuery(args ListProjectsPageArgs) (string, []any, error) {
+	var buf bytes.Buffer
+	if err := listProjectsQueryTmpl.Execute(&buf, map[string]interface{}{"Count": true}); err != nil {
+		return "", nil, err
+	}
+	return buf.String(), []any{args.Repo.Hostname, args.Repo.Owner, args.Repo.Repo, args.CategoryName, args.IncludeRemoved}, nil
+}
+
 // RenderListCollectionsArgs renders positional arguments for list collections query given repos
 func RenderListCollectionsArgs(repos []*myawesomelistv1.Repository) []any {
 	args := make([]any, 0, len(repos)*3)
@@ -218,46 +1151,186 @@ func RenderListCollectionsArgs(repos []*myawesomelistv1.Repository) []any {
 	return args
 }
 
-// RenderListCollectionsQuery builds SQL and args for listing collections filtered by repositories
-func RenderListCollectionsQuery(repos []*myawesomelistv1.Repository) (string, []any, error) {
+// RenderListCollectionsQuery builds SQL and args for listing collections filtered by repositories,
+// ordered by ID. Only collections after afterID are listed, and at most limit of them when positive.
+func RenderListCollectionsQuery(
+	repos []*myawesomelistv1.Repository,
+	afterID uint64,
+	limit int,
+) (string, []any, error) {
 	args := RenderListCollectionsArgs(repos)
+	data := map[string]interface{}{"Repos": repos}
+	if afterID > 0 {
+		args = append(args, afterID)
+		data["AfterPlaceholder"] = fmt.Sprintf("$%d", len(args))
+	}
+	if limit > 0 {
+		args = append(args, limit)
+		data["LimitPlaceholder"] = fmt.Sprintf("$%d", len(args))
+	}
 	var buf bytes.Buffer
-	if err := listCollectionsQueryTmpl.Execute(&buf, map[string]interface{}{"Repos": repos}); err != nil {
+	if err := listCollectionsQueryTmpl.Execute(&buf, data); err != nil {
 		return "", nil, err
 	}
 	return buf.String(), args, nil
 }
 
-// RenderSearchProjectsArgs renders positional arguments and placeholders for search projects query
-func RenderSearchProjectsArgs(
-	repos []*myawesomelistv1.Repository,
-	embedding *pgvector.Vector,
-	limit int,
-) ([]any, string, string) {
+// RenderCountCollectionsQuery builds SQL and args for counting collections filtered by repositories.
+func RenderCountCollectionsQuery(repos []*myawesomelistv1.Repository) (string, []any, error) {
 	args := RenderListCollectionsArgs(repos)
-	idx := 1 + len(repos)*3
-	var orderPlaceholder string
-	if embedding != nil {
-		orderPlaceholder = fmt.Sprintf("$%d", idx)
-		args = append(args, *embedding)
-		idx++
+	var buf bytes.Buffer
+	if err := listCollectionsQueryTmpl.Execute(&buf, map[string]interface{}{"Repos": repos, "Count": true}); err != nil {
+		return "", nil, err
 	}
-	limitPlaceholder := fmt.Sprintf("$%d", idx)
-	args = append(args, limit)
-	return args, orderPlaceholder, limitPlaceholder
+	return buf.String(), args, nil
 }
 
 // RenderSearchProjectsQuery builds SQL and args for searching projects filtered by repositories.
-// If embedding is non-nil, an ORDER BY clause on embedding distance is added and the embedding is appended to args.
+// Projects are ranked by fusing the full-text ranking of the query against their name, description
+// and category, with trigram matching of their name for misspellings, and the distance of their
+// embedding to the query embedding, using weighted reciprocal rank fusion of the first
+// searchProjectsCandidates projects of each ranking. A ranking is skipped when
+// its input is empty or its weight is zero, so without embeddings the search is full-text only and
+// projects without an embedding are still found by the full-text ranking.
+// Projects are narrowed down by the collection language, category, stars, last push, license and
+// host filters of args before ranking; projects without stats never match a stats filter.
+// Projects removed from their collection are skipped unless args.IncludeRemoved is set, and
+// archived projects are skipped when args.ExcludeArchived is set.
+// Embeddings are compared with distance.
+// Results start after the cursor.Offset first ones, and at most limit of them are returned.
 func RenderSearchProjectsQuery(
-	repos []*myawesomelistv1.Repository,
-	embedding *pgvector.Vector,
+	args SearchProjectsArgs,
+	distance Distance,
+	cursor *PageCursor,
 	limit int,
 ) (string, []any, error) {
-	args, orderPlaceholder, limitPlaceholder := RenderSearchProjectsArgs(repos, embedding, limit)
+	queryArgs := RenderListCollectionsArgs(args.Repos)
+	placeholder := func(v any) string {
+		queryArgs = append(queryArgs, v)
+		return fmt.Sprintf("$%d", len(queryArgs))
+	}
+	semanticWeight, lexicalWeight := 1.0, 1.0
+	if args.SemanticWeight != nil {
+		semanticWeight = *args.SemanticWeight
+	}
+	if args.LexicalWeight != nil {
+		lexicalWeight = *args.LexicalWeight
+	}
+	data := map[string]interface{}{
+		"Repos":           args.Repos,
+		"IncludeRemoved":  args.IncludeRemoved,
+		"ExcludeArchived": args.ExcludeArchived,
+		"K":               searchProjectsRRFK,
+	}
+	if args.Language != "" {
+		data["LanguagePlaceholder"] = placeholder(args.Language)
+	}
+	if args.Category != "" {
+		data["CategoryPlaceholder"] = placeholder(args.Category)
+	}
+	if args.MinStars != nil {
+		data["MinStarsPlaceholder"] = placeholder(int64(*args.MinStars))
+	}
+	if args.MaxStars != nil {
+		data["MaxStarsPlaceholder"] = placeholder(int64(*args.MaxStars))
+	}
+	if args.PushedAfter != nil {
+		data["PushedAfterPlaceholder"] = placeholder(*args.PushedAfter)
+	}
+	if args.License != "" {
+		data["LicensePlaceholder"] = placeholder(args.License)
+	}
+	if args.Host != "" {
+		data["HostPlaceholder"] = placeholder(args.Host)
+	}
+	if len(args.Embeddings) > 0 && semanticWeight != 0 {
+		embedding := placeholder(pgvector.NewVector(args.Embeddings[0]))
+		data["EmbeddingPlaceholder"] = embedding
+		data["Distance"] = distanceExpr(distance, "pe.embedding", embedding, len(args.Embeddings[0]))
+		data["Model"] = quoteLiteral(args.EmbeddingModel)
+		data["SemanticWeightPlaceholder"] = placeholder(semanticWeight)
+	}
+	if q := strings.TrimSpace(args.Query); q != "" && lexicalWeight != 0 {
+		data["QueryPlaceholder"] = placeholder(q)
+		data["LexicalWeightPlaceholder"] = placeholder(lexicalWeight)
+	}
+	if cursor != nil {
+		data["AfterPositionPlaceholder"] = placeholder(int64(cursor.Offset))
+	}
+	if data["EmbeddingPlaceholder"] != nil || data["QueryPlaceholder"] != nil {
+		data["CandidatesPlaceholder"] = placeholder(searchProjectsCandidates)
+	}
+	data["LimitPlaceholder"] = placeholder(limit)
 	var buf bytes.Buffer
-	if err := searchProjectsQueryTmpl.Execute(&buf, map[string]interface{}{"Repos": repos, "OrderPlaceholder": orderPlaceholder, "LimitPlaceholder": limitPlaceholder}); err != nil {
+	if err := searchProjectsQueryTmpl.Execute(&buf, data); err != nil {
 		return "", nil, err
 	}
-	return buf.String(), args, nil
+	return buf.String(), queryArgs, nil
+}
+
+// SetVectorSearchSettingsQuery sets for the current transaction the $1 size of the HNSW search
+// candidate list, and scans HNSW indexes iteratively until enough rows pass the filters where
+// pgvector supports it. The vector literal loads pgvector, so its settings are defined when checked.
+var SetVectorSearchSettingsQuery = strings.Join([]string{
+	"SELECT set_config('hnsw.ef_search', $1::text, true),",
+	"CASE WHEN current_setting('hnsw.iterative_scan', true) IS NOT NULL",
+	"THEN set_config('hnsw.iterative_scan', 'relaxed_order', true) END",
+	"FROM (SELECT '[0]'::vector) v",
+}, " ")
+
+// quoteLiteral quotes s as an SQL string literal, for the values planned into a query or an
+// index definition.
+func quoteLiteral(s string) string { return "'" + strings.ReplaceAll(s, "'", "''") + "'" }
+
+var ProjectEmbeddingModelsQuery = strings.Join([]string{
+	"SELECT model, dimensions, COUNT(*) FROM project_embeddings",
+	"WHERE dimensions IS NOT NULL",
+	"GROUP BY model, dimensions",
+	"ORDER BY model, COUNT(*) DESC",
+}, " ")
+
+var ProjectEmbeddingIndexesQuery = strings.Join([]string{
+	"SELECT name, model FROM project_embedding_indexes ORDER BY name",
+}, " ")
+
+var UpsertProjectEmbeddingIndexQuery = strings.Join([]string{
+	"INSERT INTO project_embedding_indexes (name, model, dimensions, method, distance, options)",
+	"VALUES ($1, $2, $3, $4, $5, $6)",
+	"ON CONFLICT (name) DO UPDATE SET model = EXCLUDED.model, dimensions = EXCLUDED.dimensions,",
+	"method = EXCLUDED.method, distance = EXCLUDED.distance, options = EXCLUDED.options, created_at = NOW()",
+}, " ")
+
+var DeleteProjectEmbeddingIndexQuery = strings.Join([]string{
+	"DELETE FROM project_embedding_indexes WHERE name = $1",
+}, " ")
+
+// RenderCreateEmbeddingIndexQuery renders the statement building the index name over the
+// embeddings of model, which have dims dimensions, with method and options for distance.
+// The index is built on the expression and predicate the search queries compare embeddings with.
+func RenderCreateEmbeddingIndexQuery(
+	name, model string,
+	dims int,
+	method VectorIndex,
+	distance Distance,
+	options string,
+) string {
+	return fmt.Sprintf(
+		"CREATE INDEX CONCURRENTLY %s ON project_embeddings USING %s ((embedding::%s) %s) WITH (%s) WHERE model = %s",
+		name,
+		method,
+		vectorType(dims),
+		distance.opsClass(vectorBaseType(dims)),
+		options,
+		quoteLiteral(model),
+	)
+}
+
+// RenderDropEmbeddingIndexQuery renders the statement dropping the embedding index name.
+func RenderDropEmbeddingIndexQuery(name string) string {
+	return "DROP INDEX CONCURRENTLY IF EXISTS " + name
+}
+
+// RenderRenameEmbeddingIndexQuery renders the statement renaming the embedding index from to to.
+func RenderRenameEmbeddingIndexQuery(from, to string) string {
+	return "ALTER INDEX " + from + " RENAME TO " + to
 }
@@ -0,0 +1,109 @@
+package database
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// Distance is the metric comparing embeddings.
+type Distance string
+
+const (
+	DistanceL2           Distance = "l2"
+	DistanceCosine       Distance = "cosine"
+	DistanceInnerProduct Distance = "inner_product"
+)
+
+// ParseDistance parses a distance name, defaulting to DistanceL2 when s is empty.
+func ParseDistance(s string) (Distance, error) {
+	switch d := Distance(strings.ToLower(s)); d {
+	case "":
+		return DistanceL2, nil
+	case DistanceL2, DistanceCosine, DistanceInnerProduct:
+		return d, nil
+	default:
+		return "", fmt.Errorf("unknown embedding distance %q", s)
+	}
+}
+
+// operator returns the pgvector operator computing the distance. The inner product operator
+// returns the negated inner product, so that smaller is nearer for every distance.
+func (d Distance) operator() string {
+	switch d {
+	case DistanceCosine:
+		return "<=>"
+	case DistanceInnerProduct:
+		return "<#>"
+	default:
+		return "<->"
+	}
+}
+
+// opsClass returns the index operator class of the distance over vectors of type typ.
+func (d Distance) opsClass(typ string) string {
+	switch d {
+	case DistanceCosine:
+		return typ + "_cosine_ops"
+	case DistanceInnerProduct:
+		return typ + "_ip_ops"
+	default:
+		return typ + "_l2_ops"
+	}
+}
+
+// VectorIndex is the approximate nearest neighbour index method of embeddings.
+type VectorIndex string
+
+const (
+	VectorIndexHNSW    VectorIndex = "hnsw"
+	VectorIndexIVFFlat VectorIndex = "ivfflat"
+	VectorIndexNone    VectorIndex = "none"
+)
+
+// ParseVectorIndex parses an index method name, defaulting to VectorIndexHNSW when s is empty.
+func ParseVectorIndex(s string) (VectorIndex, error) {
+	switch i := VectorIndex(strings.ToLower(s)); i {
+	case "":
+		return VectorIndexHNSW, nil
+	case VectorIndexHNSW, VectorIndexIVFFlat, VectorIndexNone:
+		return i, nil
+	default:
+		return "", fmt.Errorf("unknown vector index %q", s)
+	}
+}
+
+const (
+	// maxVectorIndexDims is the most dimensions pgvector indexes as vector.
+	maxVectorIndexDims = 2000
+	// maxHalfvecIndexDims is the most dimensions pgvector indexes as halfvec.
+	maxHalfvecIndexDims = 4000
+)
+
+// vectorType returns the type embeddings of dims dimensions are indexed and compared as, since
+// embeddings are stored untyped. Larger embeddings are indexed at half precision.
+func vectorType(dims int) string { return fmt.Sprintf("%s(%d)", vectorBaseType(dims), dims) }
+
+func vectorBaseType(dims int) string {
+	if dims > maxVectorIndexDims && dims <= maxHalfvecIndexDims {
+		return "halfvec"
+	}
+	return "vector"
+}
+
+// vectorIndexable reports whether embeddings of dims dimensions can be indexed.
+func vectorIndexable(dims int) bool { return dims > 0 && dims <= maxHalfvecIndexDims }
+
+// distanceExpr returns the distance between the column col and the query vector expression q
+// of dims dimensions, written as the expression indexes on col are built on.
+func distanceExpr(d Distance, col, q string, dims int) string {
+	typ := vectorType(dims)
+	return fmt.Sprintf("(%s::%s) %s %s::%s", col, typ, d.operator(), q, typ)
+}
+
+// embeddingIndexName names the index of the embeddings of model.
+func embeddingIndexName(model string) string {
+	h := sha256.Sum256([]byte(model))
+	return "idx_project_embeddings_ann_" + hex.EncodeToString(h[:6])
+}
@@ -0,0 +1,160 @@
+package encoding
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Ecosystems of the dependency manifests.
+const (
+	EcosystemGo  = "go"
+	EcosystemNpm = "npm"
+	EcosystemHex = "hex"
+)
+
+// Manifest is the package a repository publishes and the packages it depends on.
+type Manifest struct {
+	Ecosystem    string
+	Package      string
+	Dependencies []string
+}
+
+// UnmarshallGoMod parses a go.mod file, keeping direct requirements only.
+func UnmarshallGoMod(content []byte) (*Manifest, error) {
+	m := &Manifest{Ecosystem: EcosystemGo}
+	sc := bufio.NewScanner(bytes.NewReader(content))
+	var inRequire bool
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		indirect := strings.Contains(line, "// indirect")
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = strings.TrimSpace(line[:i])
+		}
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 0:
+			continue
+		case inRequire && fields[0] == ")":
+			inRequire = false
+		case inRequire:
+			if !indirect {
+				m.Dependencies = append(m.Dependencies, unquote(fields[0]))
+			}
+		case fields[0] == "module" && len(fields) >= 2:
+			m.Package = unquote(fields[1])
+		case fields[0] == "require" && len(fields) >= 2 && fields[1] == "(":
+			inRequire = true
+		case fields[0] == "require" && len(fields) >= 3:
+			if !indirect {
+				m.Dependencies = append(m.Dependencies, unquote(fields[1]))
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if m.Package == "" {
+		return nil, fmt.Errorf("go.mod has no module directive")
+	}
+	m.Dependencies = compact(m.Dependencies)
+	return m, nil
+}
+
+// UnmarshallPackageJSON parses a package.json file, keeping runtime and peer dependencies.
+func UnmarshallPackageJSON(content []byte) (*Manifest, error) {
+	var pkg struct {
+		Name                 string            `json:"name"`
+		Dependencies         map[string]string `json:"dependencies"`
+		PeerDependencies     map[string]string `json:"peerDependencies"`
+		OptionalDependencies map[string]string `json:"optionalDependencies"`
+	}
+	if err := json.Unmarshal(content, &pkg); err != nil {
+		return nil, fmt.Errorf("invalid package.json: %w", err)
+	}
+	m := &Manifest{Ecosystem: EcosystemNpm, Package: pkg.Name}
+	for _, deps := range []map[string]string{pkg.Dependencies, pkg.PeerDependencies, pkg.OptionalDependencies} {
+		for name := range deps {
+			m.Dependencies = append(m.Dependencies, name)
+		}
+	}
+	m.Dependencies = compact(m.Dependencies)
+	return m, nil
+}
+
+var (
+	mixAppRe     = regexp.MustCompile(`\bapp:\s*:([a-z_][a-zA-Z0-9_]*)`)
+	mixPackageRe = regexp.MustCompile(`\bdefp?\s+package\b(?:\s*\(\s*\))?\s*,?\s*do[\s\S]{0,500}?\bname:\s*"([^"]+)"`)
+	mixDepsRe    = regexp.MustCompile(`\bdefp?\s+deps\b`)
+	mixDepRe     = regexp.MustCompile(`\{\s*:([a-z_][a-zA-Z0-9_]*)\s*,`)
+	// mixIgnoredRe matches the strings and comments, which may hold keywords.
+	mixIgnoredRe = regexp.MustCompile(`"(?:[^"\\]|\\.)*"|#[^\n]*`)
+	mixTokenRe   = regexp.MustCompile(`\bdo:|\b(?:do|fn|end)\b|[\[\]]`)
+)
+
+// mixDepsBlock returns the body of the deps function of a mix.exs file, up to the end matching
+// its do, or up to the bracket closing its list in the do: form. It returns nil without one.
+func mixDepsBlock(content []byte) []byte {
+	code := mixIgnoredRe.ReplaceAllFunc(content, func(b []byte) []byte {
+		return bytes.Repeat([]byte{' '}, len(b))
+	})
+	loc := mixDepsRe.FindIndex(code)
+	if loc == nil {
+		return nil
+	}
+	start := loc[1]
+	var inline bool
+	var depth int
+	for _, t := range mixTokenRe.FindAllIndex(code[start:], -1) {
+		tok, stop := string(code[start+t[0]:start+t[1]]), start+t[1]
+		switch {
+		case depth == 0 && !inline && tok == "do:":
+			inline = true
+		case depth == 0 && !inline && tok == "do":
+			depth = 1
+		case inline && tok == "[":
+			depth++
+		case inline && tok == "]":
+			if depth--; depth == 0 {
+				return content[start:stop]
+			}
+		case !inline && depth > 0 && (tok == "do" || tok == "fn"):
+			depth++
+		case !inline && depth > 0 && tok == "end":
+			if depth--; depth == 0 {
+				return content[start:stop]
+			}
+		}
+	}
+	return content[start:]
+}
+
+// UnmarshallMixExs extracts the application name and the dependencies declared in a mix.exs file.
+// The file is not evaluated, so dependencies built at runtime are missed.
+func UnmarshallMixExs(content []byte) (*Manifest, error) {
+	m := &Manifest{Ecosystem: EcosystemHex}
+	if sm := mixPackageRe.FindSubmatch(content); sm != nil {
+		m.Package = string(sm[1])
+	} else if sm := mixAppRe.FindSubmatch(content); sm != nil {
+		m.Package = string(sm[1])
+	}
+	if m.Package == "" {
+		return nil, fmt.Errorf("mix.exs has no application name")
+	}
+	for _, sm := range mixDepRe.FindAllSubmatch(mixDepsBlock(content), -1) {
+		m.Dependencies = append(m.Dependencies, string(sm[1]))
+	}
+	m.Dependencies = compact(m.Dependencies)
+	return m, nil
+}
+
+func unquote(s string) string { return strings.Trim(s, "\"`") }
+
+func compact(deps []string) []string {
+	slices.Sort(deps)
+	return slices.Compact(deps)
+}
@@ -0,0 +1,254 @@
+package encoding
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestUnmarshallGoMod(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    *Manifest
+		wantErr bool
+	}{
+		{
+			name: "require and replace blocks",
+			content: `module github.com/acme/widget
+
+go 1.22
+
+toolchain go1.22.4
+
+require (
+	github.com/jackc/pgx/v5 v5.7.1
+	"github.com/quoted/mod" v1.0.0
+	golang.org/x/sync v0.8.0 // indirect
+)
+
+require github.com/spf13/cobra v1.8.1
+
+require golang.org/x/text v0.18.0 // indirect
+
+replace (
+	github.com/jackc/pgx/v5 => ../pgx
+	github.com/old/mod v1.0.0 => github.com/new/mod v1.1.0
+)
+
+replace github.com/single/mod => ./single
+
+exclude github.com/bad/mod v0.1.0
+
+retract (
+	v1.0.0 // published by mistake
+)
+`,
+			want: &Manifest{
+				Ecosystem: EcosystemGo,
+				Package:   "github.com/acme/widget",
+				Dependencies: []string{
+					"github.com/jackc/pgx/v5",
+					"github.com/quoted/mod",
+					"github.com/spf13/cobra",
+				},
+			},
+		},
+		{
+			name:    "quoted module path without requirements",
+			content: "module \"example.com/tool\"\n\ngo 1.21\n",
+			want:    &Manifest{Ecosystem: EcosystemGo, Package: "example.com/tool"},
+		},
+		{
+			name:    "no module directive",
+			content: "go 1.22\n\nrequire github.com/spf13/cobra v1.8.1\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnmarshallGoMod([]byte(tt.content))
+			checkManifest(t, got, err, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func TestUnmarshallPackageJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    *Manifest
+		wantErr bool
+	}{
+		{
+			name: "runtime, peer and optional dependencies",
+			content: `{
+  "name": "@acme/widget",
+  "version": "2.0.0",
+  "dependencies": {"react-dom": "^18.2.0", "lodash": "^4.17.21"},
+  "devDependencies": {"typescript": "^5.4.0", "vitest": "^1.6.0"},
+  "peerDependencies": {"react": ">=17", "react-dom": ">=17"},
+  "optionalDependencies": {"fsevents": "^2.3.3"}
+}`,
+			want: &Manifest{
+				Ecosystem:    EcosystemNpm,
+				Package:      "@acme/widget",
+				Dependencies: []string{"fsevents", "lodash", "react", "react-dom"},
+			},
+		},
+		{
+			name:    "private workspace root",
+			content: `{"private": true, "workspaces": ["packages/*"], "devDependencies": {"turbo": "^2.0.0"}}`,
+			want:    &Manifest{Ecosystem: EcosystemNpm},
+		},
+		{
+			name:    "malformed JSON",
+			content: `{"name": "widget",`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnmarshallPackageJSON([]byte(tt.content))
+			checkManifest(t, got, err, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func TestUnmarshallMixExs(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    *Manifest
+		wantErr bool
+	}{
+		{
+			name: "package name and deps",
+			content: `defmodule Acme.MixProject do
+  use Mix.Project
+
+  def project do
+    [
+      app: :acme,
+      version: "0.1.0",
+      deps: deps(),
+      package: package()
+    ]
+  end
+
+  defp package do
+    [
+      name: "acme_widget",
+      licenses: ["MIT"]
+    ]
+  end
+
+  # Run "mix help deps" to learn about dependencies.
+  defp deps do
+    [
+      {:phoenix, "~> 1.7"},
+      # Pinned until the end of the migration.
+      {:ecto_sql, "~> 3.10"},
+      {:credo, "~> 1.7", only: [:dev, :test], runtime: false},
+      {:plug, github: "elixir-plug/plug", branch: "main"}
+    ]
+  end
+end
+`,
+			want: &Manifest{
+				Ecosystem:    EcosystemHex,
+				Package:      "acme_widget",
+				Dependencies: []string{"credo", "ecto_sql", "phoenix", "plug"},
+			},
+		},
+		{
+			name: "conditional deps",
+			content: `defmodule Widget.MixProject do
+  use Mix.Project
+
+  def project do
+    [app: :widget, version: "1.0.0", deps: deps()]
+  end
+
+  defp deps do
+    base = [
+      {:jason, "~> 1.4"},
+      {:telemetry, "~> 1.0"}
+    ]
+
+    if Mix.env() == :test do
+      base ++ [{:mox, "~> 1.0", only: :test}]
+    else
+      base
+    end ++
+      Enum.map(extras(), fn name -> {name, ">= 0.0.0"} end) ++
+      [{:nimble_options, "~> 1.0"}]
+  end
+
+  defp extras, do: []
+
+  defp aliases do
+    [{:not_a_dep, "setup"}]
+  end
+end
+`,
+			want: &Manifest{
+				Ecosystem:    EcosystemHex,
+				Package:      "widget",
+				Dependencies: []string{"jason", "mox", "nimble_options", "telemetry"},
+			},
+		},
+		{
+			name: "keyword do form",
+			content: `defmodule Tiny.MixProject do
+  use Mix.Project
+
+  def project, do: [app: :tiny, version: "0.2.0", deps: deps(), package: package()]
+
+  defp package, do: [name: "tiny_decimal"]
+
+  defp deps,
+    do: [
+      {:decimal, "~> 2.0", optional: true}
+    ]
+
+  defp docs do
+    [{:not_a_dep, "README.md"}]
+  end
+end
+`,
+			want: &Manifest{
+				Ecosystem:    EcosystemHex,
+				Package:      "tiny_decimal",
+				Dependencies: []string{"decimal"},
+			},
+		},
+		{
+			name:    "no application name",
+			content: "defmodule Broken do\nend\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := UnmarshallMixExs([]byte(tt.content))
+			checkManifest(t, got, err, tt.want, tt.wantErr)
+		})
+	}
+}
+
+func checkManifest(t *testing.T, got *Manifest, err error, want *Manifest, wantErr bool) {
+	t.Helper()
+	if wantErr {
+		if err == nil {
+			t.Fatalf("got %+v, want an error", got)
+		}
+		return
+	}
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Ecosystem != want.Ecosystem || got.Package != want.Package ||
+		!slices.Equal(got.Dependencies, want.Dependencies) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+}
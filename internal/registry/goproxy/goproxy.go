@@ -0,0 +1,164 @@
+package goproxy
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"golang.org/x/time/rate"
+	"myawesomelist.shikanime.studio/internal/encoding"
+	"myawesomelist.shikanime.studio/internal/registry"
+	myawesomelistv1 "myawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1"
+)
+
+const DefaultProxyURL = "https://proxy.golang.org"
+
+// Provider looks up modules on a Go module proxy and lists their versions.
+// Module proxies do not report downloads.
+type Provider struct {
+	c        *http.Client
+	l        *rate.Limiter
+	proxyURL string
+}
+
+var _ registry.Provider = (*Provider)(nil)
+
+// ProviderOptions configures the Go module proxy provider.
+type ProviderOptions struct {
+	client   *http.Client
+	limiter  *rate.Limiter
+	proxyURL string
+}
+
+// ProviderOption applies a configuration to ProviderOptions.
+type ProviderOption func(*ProviderOptions)
+
+// WithHTTPClient sets the HTTP client used for proxy calls.
+func WithHTTPClient(c *http.Client) ProviderOption {
+	return func(o *ProviderOptions) { o.client = c }
+}
+
+// WithLimiter sets the rate limiter used for proxy calls.
+func WithLimiter(l *rate.Limiter) ProviderOption {
+	return func(o *ProviderOptions) { o.limiter = l }
+}
+
+// WithProxyURL sets the base URL of the module proxy.
+func WithProxyURL(u string) ProviderOption {
+	return func(o *ProviderOptions) { o.proxyURL = u }
+}
+
+// NewProvider constructs a Go module proxy Provider with the given options.
+func NewProvider(opts ...ProviderOption) *Provider {
+	o := ProviderOptions{client: http.DefaultClient, proxyURL: DefaultProxyURL}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Provider{c: o.client, l: o.limiter, proxyURL: strings.TrimSuffix(o.proxyURL, "/")}
+}
+
+func (p *Provider) Registry() string { return "goproxy" }
+
+func (p *Provider) Languages() []string { return []string{"Go"} }
+
+func (p *Provider) Ecosystem() string { return encoding.EcosystemGo }
+
+// PackageName resolves the module path declared by the go.mod of the repository, or else matching
+// the repository URL, when the proxy knows it.
+func (p *Provider) PackageName(
+	ctx context.Context,
+	repo *myawesomelistv1.Repository,
+	manifest string,
+) (string, error) {
+	var candidates []string
+	if manifest != "" {
+		candidates = append(candidates, manifest)
+	}
+	if path := repo.Hostname + "/" + repo.Owner + "/" + repo.Repo; path != manifest {
+		candidates = append(candidates, path)
+	}
+	for _, path := range candidates {
+		_, err := p.latest(ctx, path)
+		if errors.Is(err, registry.ErrPackageNotFound) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		return path, nil
+	}
+	return "", registry.ErrPackageNotFound
+}
+
+// Stats lists the published versions and the latest version of a module.
+func (p *Provider) Stats(ctx context.Context, name string) (*registry.Stats, error) {
+	latest, err := p.latest(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := p.versions(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return &registry.Stats{
+		Package:       name,
+		LatestVersion: latest,
+		VersionsCount: &versions,
+	}, nil
+}
+
+func (p *Provider) latest(ctx context.Context, path string) (string, error) {
+	escaped, err := escapePath(path)
+	if err != nil {
+		return "", err
+	}
+	var info struct {
+		Version string `json:"Version"`
+	}
+	if err := registry.GetJSON(ctx, p.c, p.l, p.proxyURL+"/"+escaped+"/@latest", &info); err != nil {
+		return "", err
+	}
+	return info.Version, nil
+}
+
+func (p *Provider) versions(ctx context.Context, path string) (uint32, error) {
+	escaped, err := escapePath(path)
+	if err != nil {
+		return 0, err
+	}
+	body, err := registry.Get(ctx, p.c, p.l, p.proxyURL+"/"+escaped+"/@v/list")
+	if err != nil {
+		return 0, err
+	}
+	defer body.Close()
+	var n uint32
+	sc := bufio.NewScanner(body)
+	for sc.Scan() {
+		if strings.TrimSpace(sc.Text()) != "" {
+			n++
+		}
+	}
+	return n, sc.Err()
+}
+
+// escapePath applies the module proxy case encoding, replacing upper case letters by an
+// exclamation mark followed by their lower case.
+func escapePath(path string) (string, error) {
+	var b strings.Builder
+	for _, r := range path {
+		switch {
+		case r == '!' || r >= unicode.MaxASCII:
+			return "", fmt.Errorf("invalid module path %q", path)
+		case 'A' <= r && r <= 'Z':
+			b.WriteByte('!')
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,112 @@
+package goproxy
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myawesomelist.shikanime.studio/internal/registry"
+	myawesomelistv1 "myawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1"
+)
+
+// newTestProvider serves the given bodies by path, and 404 for any other path.
+func newTestProvider(t *testing.T, bodies map[string]string) *Provider {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := bodies[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return NewProvider(WithHTTPClient(srv.Client()), WithProxyURL(srv.URL))
+}
+
+func TestPackageName(t *testing.T) {
+	p := newTestProvider(t, map[string]string{
+		"/github.com/!burnt!sushi/toml/@latest": `{"Version":"v1.4.0"}`,
+		"/github.com/acme/widget/v2/@latest":    `{"Version":"v2.1.0"}`,
+		"/github.com/acme/broken/@latest":       `{"Version":`,
+	})
+	tests := []struct {
+		name     string
+		repo     *myawesomelistv1.Repository
+		manifest string
+		want     string
+		wantErr  error
+	}{
+		{
+			name: "repository path with upper case",
+			repo: &myawesomelistv1.Repository{Hostname: "github.com", Owner: "BurntSushi", Repo: "toml"},
+			want: "github.com/BurntSushi/toml",
+		},
+		{
+			name:     "module path from manifest",
+			repo:     &myawesomelistv1.Repository{Hostname: "github.com", Owner: "acme", Repo: "widget"},
+			manifest: "github.com/acme/widget/v2",
+			want:     "github.com/acme/widget/v2",
+		},
+		{
+			name:     "not found",
+			repo:     &myawesomelistv1.Repository{Hostname: "github.com", Owner: "acme", Repo: "missing"},
+			manifest: "example.com/missing",
+			wantErr:  registry.ErrPackageNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := p.PackageName(context.Background(), tt.repo, tt.manifest)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PackageName() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PackageName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	t.Run("malformed JSON", func(t *testing.T) {
+		repo := &myawesomelistv1.Repository{Hostname: "github.com", Owner: "acme", Repo: "broken"}
+		_, err := p.PackageName(context.Background(), repo, "")
+		if err == nil || errors.Is(err, registry.ErrPackageNotFound) {
+			t.Fatalf("PackageName() error = %v, want a decoding error", err)
+		}
+	})
+}
+
+func TestStats(t *testing.T) {
+	p := newTestProvider(t, map[string]string{
+		"/github.com/acme/widget/@latest": `{"Version":"v1.2.0"}`,
+		"/github.com/acme/widget/@v/list": "v1.0.0\nv1.1.0\nv1.2.0\n",
+		"/github.com/acme/broken/@latest": `<html>`,
+	})
+	t.Run("success", func(t *testing.T) {
+		stats, err := p.Stats(context.Background(), "github.com/acme/widget")
+		if err != nil {
+			t.Fatalf("Stats() error = %v", err)
+		}
+		if stats.LatestVersion != "v1.2.0" {
+			t.Errorf("Stats().LatestVersion = %q, want v1.2.0", stats.LatestVersion)
+		}
+		if stats.VersionsCount == nil || *stats.VersionsCount != 3 {
+			t.Errorf("Stats().VersionsCount = %v, want 3", stats.VersionsCount)
+		}
+		if stats.Downloads != nil {
+			t.Errorf("Stats().Downloads = %d, want nil", *stats.Downloads)
+		}
+	})
+	t.Run("not found", func(t *testing.T) {
+		if _, err := p.Stats(context.Background(), "github.com/acme/missing"); !errors.Is(err, registry.ErrPackageNotFound) {
+			t.Fatalf("Stats() error = %v, want %v", err, registry.ErrPackageNotFound)
+		}
+	})
+	t.Run("malformed JSON", func(t *testing.T) {
+		_, err := p.Stats(context.Background(), "github.com/acme/broken")
+		if err == nil || errors.Is(err, registry.ErrPackageNotFound) {
+			t.Fatalf("Stats() error = %v, want a decoding error", err)
+		}
+	})
+}
@@ -0,0 +1,147 @@
+package hex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"golang.org/x/time/rate"
+	"myawesomelist.shikanime.studio/internal/encoding"
+	"myawesomelist.shikanime.studio/internal/registry"
+	myawesomelistv1 "myawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1"
+)
+
+const DefaultAPIURL = "https://hex.pm/api"
+
+// Provider looks up packages on Hex.pm and their recent downloads.
+type Provider struct {
+	c      *http.Client
+	l      *rate.Limiter
+	apiURL string
+}
+
+var _ registry.Provider = (*Provider)(nil)
+
+// ProviderOptions configures the Hex provider.
+type ProviderOptions struct {
+	client  *http.Client
+	limiter *rate.Limiter
+	apiURL  string
+}
+
+// ProviderOption applies a configuration to ProviderOptions.
+type ProviderOption func(*ProviderOptions)
+
+// WithHTTPClient sets the HTTP client used for API calls.
+func WithHTTPClient(c *http.Client) ProviderOption {
+	return func(o *ProviderOptions) { o.client = c }
+}
+
+// WithLimiter sets the rate limiter used for API calls.
+func WithLimiter(l *rate.Limiter) ProviderOption {
+	return func(o *ProviderOptions) { o.limiter = l }
+}
+
+// WithAPIURL sets the base URL of the Hex API.
+func WithAPIURL(u string) ProviderOption {
+	return func(o *ProviderOptions) { o.apiURL = u }
+}
+
+// NewProvider constructs a Hex Provider with the given options.
+func NewProvider(opts ...ProviderOption) *Provider {
+	o := ProviderOptions{client: http.DefaultClient, apiURL: DefaultAPIURL}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Provider{c: o.client, l: o.limiter, apiURL: strings.TrimSuffix(o.apiURL, "/")}
+}
+
+func (p *Provider) Registry() string { return "hex" }
+
+func (p *Provider) Languages() []string { return []string{"Elixir", "Erlang"} }
+
+func (p *Provider) Ecosystem() string { return encoding.EcosystemHex }
+
+type hexPackage struct {
+	Name      string `json:"name"`
+	Downloads struct {
+		All    uint64 `json:"all"`
+		Recent uint64 `json:"recent"`
+	} `json:"downloads"`
+	Meta struct {
+		Links map[string]string `json:"links"`
+	} `json:"meta"`
+	LatestVersion       string `json:"latest_version"`
+	LatestStableVersion string `json:"latest_stable_version"`
+	Releases            []struct {
+		Version string `json:"version"`
+	} `json:"releases"`
+}
+
+// PackageName resolves the package named by the mix.exs of the repository, or else named after the
+// repository, trying the snake case spelling too, whose links point back to it.
+func (p *Provider) PackageName(
+	ctx context.Context,
+	repo *myawesomelistv1.Repository,
+	manifest string,
+) (string, error) {
+	var candidates []string
+	if manifest != "" {
+		candidates = append(candidates, manifest)
+	}
+	name := strings.ToLower(repo.Repo)
+	for _, c := range []string{name, strings.ReplaceAll(name, "-", "_")} {
+		if !slices.Contains(candidates, c) {
+			candidates = append(candidates, c)
+		}
+	}
+	for _, candidate := range candidates {
+		pkg, err := p.get(ctx, candidate)
+		if errors.Is(err, registry.ErrPackageNotFound) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		for _, link := range pkg.Meta.Links {
+			if registry.MatchesRepository(link, repo) {
+				return pkg.Name, nil
+			}
+		}
+	}
+	return "", registry.ErrPackageNotFound
+}
+
+// Stats fetches the downloads of the last 90 days, the latest version and the number of releases of a package.
+func (p *Provider) Stats(ctx context.Context, name string) (*registry.Stats, error) {
+	pkg, err := p.get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	latest := pkg.LatestStableVersion
+	if latest == "" {
+		latest = pkg.LatestVersion
+	}
+	versions := uint32(len(pkg.Releases))
+	return &registry.Stats{
+		Package:         pkg.Name,
+		Downloads:       &pkg.Downloads.Recent,
+		DownloadsPeriod: "last-90-days",
+		LatestVersion:   latest,
+		VersionsCount:   &versions,
+	}, nil
+}
+
+func (p *Provider) get(ctx context.Context, name string) (*hexPackage, error) {
+	var pkg hexPackage
+	if err := registry.GetJSON(ctx, p.c, p.l, p.apiURL+"/packages/"+url.PathEscape(name), &pkg); err != nil {
+		return nil, err
+	}
+	if pkg.Name == "" {
+		pkg.Name = name
+	}
+	return &pkg, nil
+}
@@ -0,0 +1,117 @@
+package hex
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myawesomelist.shikanime.studio/internal/registry"
+	myawesomelistv1 "myawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1"
+)
+
+// newTestProvider serves the given bodies by path, and 404 for any other path.
+func newTestProvider(t *testing.T, bodies map[string]string) *Provider {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := bodies[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return NewProvider(WithHTTPClient(srv.Client()), WithAPIURL(srv.URL+"/api"))
+}
+
+func TestPackageName(t *testing.T) {
+	p := newTestProvider(t, map[string]string{
+		"/api/packages/live_widget": `{"name":"live_widget","meta":{"links":{"GitHub":"https://github.com/acme/live-widget"}}}`,
+		"/api/packages/acme_sql":    `{"name":"acme_sql","meta":{"links":{"Source":"https://github.com/acme/sql"}}}`,
+		"/api/packages/elsewhere":   `{"name":"elsewhere","meta":{"links":{"GitHub":"https://github.com/someone/elsewhere"}}}`,
+		"/api/packages/broken":      `{"name":"broken","meta":`,
+	})
+	tests := []struct {
+		name     string
+		repo     *myawesomelistv1.Repository
+		manifest string
+		want     string
+		wantErr  error
+	}{
+		{
+			name:     "package from manifest",
+			repo:     &myawesomelistv1.Repository{Hostname: "github.com", Owner: "acme", Repo: "sql"},
+			manifest: "acme_sql",
+			want:     "acme_sql",
+		},
+		{
+			name: "snake case repository name",
+			repo: &myawesomelistv1.Repository{Hostname: "github.com", Owner: "acme", Repo: "live-widget"},
+			want: "live_widget",
+		},
+		{
+			name:    "links pointing elsewhere",
+			repo:    &myawesomelistv1.Repository{Hostname: "github.com", Owner: "acme", Repo: "elsewhere"},
+			wantErr: registry.ErrPackageNotFound,
+		},
+		{
+			name:    "not found",
+			repo:    &myawesomelistv1.Repository{Hostname: "github.com", Owner: "acme", Repo: "missing"},
+			wantErr: registry.ErrPackageNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := p.PackageName(context.Background(), tt.repo, tt.manifest)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PackageName() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PackageName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	t.Run("malformed JSON", func(t *testing.T) {
+		repo := &myawesomelistv1.Repository{Hostname: "github.com", Owner: "acme", Repo: "broken"}
+		_, err := p.PackageName(context.Background(), repo, "")
+		if err == nil || errors.Is(err, registry.ErrPackageNotFound) {
+			t.Fatalf("PackageName() error = %v, want a decoding error", err)
+		}
+	})
+}
+
+func TestStats(t *testing.T) {
+	p := newTestProvider(t, map[string]string{
+		"/api/packages/jason":  `{"name":"jason","downloads":{"all":100,"recent":42},"latest_version":"1.5.0-rc.1","latest_stable_version":"1.4.4","releases":[{"version":"1.5.0-rc.1"},{"version":"1.4.4"}]}`,
+		"/api/packages/broken": `[`,
+	})
+	t.Run("success", func(t *testing.T) {
+		stats, err := p.Stats(context.Background(), "jason")
+		if err != nil {
+			t.Fatalf("Stats() error = %v", err)
+		}
+		if stats.LatestVersion != "1.4.4" {
+			t.Errorf("Stats().LatestVersion = %q, want 1.4.4", stats.LatestVersion)
+		}
+		if stats.Downloads == nil || *stats.Downloads != 42 {
+			t.Errorf("Stats().Downloads = %v, want 42", stats.Downloads)
+		}
+		if stats.VersionsCount == nil || *stats.VersionsCount != 2 {
+			t.Errorf("Stats().VersionsCount = %v, want 2", stats.VersionsCount)
+		}
+	})
+	t.Run("not found", func(t *testing.T) {
+		if _, err := p.Stats(context.Background(), "missing"); !errors.Is(err, registry.ErrPackageNotFound) {
+			t.Fatalf("Stats() error = %v, want %v", err, registry.ErrPackageNotFound)
+		}
+	})
+	t.Run("malformed JSON", func(t *testing.T) {
+		_, err := p.Stats(context.Background(), "broken")
+		if err == nil || errors.Is(err, registry.ErrPackageNotFound) {
+			t.Fatalf("Stats() error = %v, want a decoding error", err)
+		}
+	})
+}
@@ -0,0 +1,175 @@
+package npm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"golang.org/x/time/rate"
+	"myawesomelist.shikanime.studio/internal/encoding"
+	"myawesomelist.shikanime.studio/internal/registry"
+	myawesomelistv1 "myawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1"
+)
+
+const (
+	DefaultRegistryURL  = "https://registry.npmjs.org"
+	DefaultDownloadsURL = "https://api.npmjs.org"
+)
+
+// Provider looks up packages on the npm registry and their monthly downloads.
+type Provider struct {
+	c            *http.Client
+	l            *rate.Limiter
+	registryURL  string
+	downloadsURL string
+}
+
+var _ registry.Provider = (*Provider)(nil)
+
+// ProviderOptions configures the npm provider.
+type ProviderOptions struct {
+	client       *http.Client
+	limiter      *rate.Limiter
+	registryURL  string
+	downloadsURL string
+}
+
+// ProviderOption applies a configuration to ProviderOptions.
+type ProviderOption func(*ProviderOptions)
+
+// WithHTTPClient sets the HTTP client used for registry calls.
+func WithHTTPClient(c *http.Client) ProviderOption {
+	return func(o *ProviderOptions) { o.client = c }
+}
+
+// WithLimiter sets the rate limiter used for registry calls.
+func WithLimiter(l *rate.Limiter) ProviderOption {
+	return func(o *ProviderOptions) { o.limiter = l }
+}
+
+// WithRegistryURL sets the base URL of the package metadata registry.
+func WithRegistryURL(u string) ProviderOption {
+	return func(o *ProviderOptions) { o.registryURL = u }
+}
+
+// WithDownloadsURL sets the base URL of the download counts API.
+func WithDownloadsURL(u string) ProviderOption {
+	return func(o *ProviderOptions) { o.downloadsURL = u }
+}
+
+// NewProvider constructs an npm Provider with the given options.
+func NewProvider(opts ...ProviderOption) *Provider {
+	o := ProviderOptions{
+		client:       http.DefaultClient,
+		registryURL:  DefaultRegistryURL,
+		downloadsURL: DefaultDownloadsURL,
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return &Provider{
+		c:            o.client,
+		l:            o.limiter,
+		registryURL:  strings.TrimSuffix(o.registryURL, "/"),
+		downloadsURL: strings.TrimSuffix(o.downloadsURL, "/"),
+	}
+}
+
+func (p *Provider) Registry() string { return "npm" }
+
+func (p *Provider) Languages() []string { return []string{"JavaScript", "TypeScript"} }
+
+func (p *Provider) Ecosystem() string { return encoding.EcosystemNpm }
+
+type manifest struct {
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	Repository any    `json:"repository"`
+}
+
+// repositoryURL returns the repository URL of a manifest, given either as a string or an object.
+func (m manifest) repositoryURL() string {
+	switch r := m.Repository.(type) {
+	case string:
+		return r
+	case map[string]any:
+		u, _ := r["url"].(string)
+		return u
+	default:
+		return ""
+	}
+}
+
+// PackageName resolves the package named by the package.json of the repository, such as a scoped
+// package, or else named after the repository, whose latest manifest points back to it.
+func (p *Provider) PackageName(
+	ctx context.Context,
+	repo *myawesomelistv1.Repository,
+	manifest string,
+) (string, error) {
+	var candidates []string
+	if manifest != "" {
+		candidates = append(candidates, manifest)
+	}
+	if name := strings.ToLower(repo.Repo); name != manifest {
+		candidates = append(candidates, name)
+	}
+	for _, candidate := range candidates {
+		m, err := p.latest(ctx, candidate)
+		if errors.Is(err, registry.ErrPackageNotFound) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		if registry.MatchesRepository(m.repositoryURL(), repo) {
+			return m.Name, nil
+		}
+	}
+	return "", registry.ErrPackageNotFound
+}
+
+// Stats fetches the last month downloads and latest version of a package.
+func (p *Provider) Stats(ctx context.Context, name string) (*registry.Stats, error) {
+	m, err := p.latest(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	var downloads struct {
+		Downloads uint64 `json:"downloads"`
+	}
+	err = registry.GetJSON(
+		ctx,
+		p.c,
+		p.l,
+		p.downloadsURL+"/downloads/point/last-month/"+name,
+		&downloads,
+	)
+	stats := &registry.Stats{
+		Package:         name,
+		DownloadsPeriod: "last-month",
+		LatestVersion:   m.Version,
+	}
+	switch {
+	case err == nil:
+		stats.Downloads = &downloads.Downloads
+	case errors.Is(err, registry.ErrPackageNotFound):
+		// Freshly published packages have no download counts yet.
+	default:
+		return nil, err
+	}
+	return stats, nil
+}
+
+func (p *Provider) latest(ctx context.Context, name string) (*manifest, error) {
+	var m manifest
+	if err := registry.GetJSON(ctx, p.c, p.l, p.registryURL+"/"+url.PathEscape(name)+"/latest", &m); err != nil {
+		return nil, err
+	}
+	if m.Name == "" {
+		m.Name = name
+	}
+	return &m, nil
+}
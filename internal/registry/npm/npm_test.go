@@ -0,0 +1,115 @@
+package npm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"myawesomelist.shikanime.studio/internal/registry"
+	myawesomelistv1 "myawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1"
+)
+
+// newTestProvider serves the given bodies by escaped path, and 404 for any other path.
+func newTestProvider(t *testing.T, bodies map[string]string) *Provider {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, ok := bodies[r.URL.EscapedPath()]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+	return NewProvider(
+		WithHTTPClient(srv.Client()),
+		WithRegistryURL(srv.URL),
+		WithDownloadsURL(srv.URL+"/api"),
+	)
+}
+
+var repo = &myawesomelistv1.Repository{Hostname: "github.com", Owner: "acme", Repo: "Widget"}
+
+func TestPackageName(t *testing.T) {
+	p := newTestProvider(t, map[string]string{
+		"/@acme%2Fwidget/latest": `{"name":"@acme/widget","version":"2.0.0","repository":{"type":"git","url":"git+https://github.com/acme/widget.git"}}`,
+		"/widget/latest":         `{"name":"widget","version":"1.0.0","repository":"github:someone/widget"}`,
+		"/other/latest":          `{"name":"other","version":"1.0.0","repository":"https://github.com/acme/widget"}`,
+		"/gadget/latest":         `{"name":"gadget","version":"1.0.0","repository":"git@github.com:acme/gadget.git"}`,
+		"/broken/latest":         `{"name":`,
+	})
+	gadget := &myawesomelistv1.Repository{Hostname: "github.com", Owner: "acme", Repo: "gadget"}
+	tests := []struct {
+		name     string
+		repo     *myawesomelistv1.Repository
+		manifest string
+		want     string
+		wantErr  error
+	}{
+		{name: "scoped package from manifest", repo: repo, manifest: "@acme/widget", want: "@acme/widget"},
+		{name: "unscoped package from manifest", repo: repo, manifest: "other", want: "other"},
+		{name: "repository name without manifest", repo: gadget, want: "gadget"},
+		{name: "repository name when manifest is not published", repo: gadget, manifest: "private-root", want: "gadget"},
+		{name: "repository name pointing elsewhere", repo: repo, wantErr: registry.ErrPackageNotFound},
+		{name: "not found", repo: &myawesomelistv1.Repository{Hostname: "github.com", Owner: "acme", Repo: "missing"}, wantErr: registry.ErrPackageNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := p.PackageName(context.Background(), tt.repo, tt.manifest)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("PackageName() error = %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PackageName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+	t.Run("malformed JSON", func(t *testing.T) {
+		_, err := p.PackageName(context.Background(), repo, "broken")
+		if err == nil || errors.Is(err, registry.ErrPackageNotFound) {
+			t.Fatalf("PackageName() error = %v, want a decoding error", err)
+		}
+	})
+}
+
+func TestStats(t *testing.T) {
+	p := newTestProvider(t, map[string]string{
+		"/@acme%2Fwidget/latest":                       `{"name":"@acme/widget","version":"2.0.0"}`,
+		"/api/downloads/point/last-month/@acme/widget": `{"downloads":1234}`,
+		"/fresh/latest":                                `{"name":"fresh","version":"0.1.0"}`,
+		"/broken/latest":                               `{"name":"broken","version":"1.0.0"}`,
+		"/api/downloads/point/last-month/broken":       `not json`,
+	})
+	t.Run("success", func(t *testing.T) {
+		stats, err := p.Stats(context.Background(), "@acme/widget")
+		if err != nil {
+			t.Fatalf("Stats() error = %v", err)
+		}
+		if stats.LatestVersion != "2.0.0" || stats.Downloads == nil || *stats.Downloads != 1234 {
+			t.Errorf("Stats() = %+v, want version 2.0.0 and 1234 downloads", stats)
+		}
+	})
+	t.Run("no downloads yet", func(t *testing.T) {
+		stats, err := p.Stats(context.Background(), "fresh")
+		if err != nil {
+			t.Fatalf("Stats() error = %v", err)
+		}
+		if stats.Downloads != nil {
+			t.Errorf("Stats().Downloads = %d, want nil", *stats.Downloads)
+		}
+	})
+	t.Run("not found", func(t *testing.T) {
+		if _, err := p.Stats(context.Background(), "missing"); !errors.Is(err, registry.ErrPackageNotFound) {
+			t.Fatalf("Stats() error = %v, want %v", err, registry.ErrPackageNotFound)
+		}
+	})
+	t.Run("malformed JSON", func(t *testing.T) {
+		_, err := p.Stats(context.Background(), "broken")
+		if err == nil || errors.Is(err, registry.ErrPackageNotFound) {
+			t.Fatalf("Stats() error = %v, want a decoding error", err)
+		}
+	})
+}
@@ -0,0 +1,196 @@
+package registry
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"golang.org/x/time/rate"
+	"myawesomelist.shikanime.studio/internal/database"
+	myawesomelistv1 "myawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1"
+)
+
+// ErrPackageNotFound is returned when a repository or package is not published on a registry.
+var ErrPackageNotFound = errors.New("package not found")
+
+// Stats holds the popularity numbers of a package on a registry.
+type Stats struct {
+	Package string
+	// Downloads over DownloadsPeriod, nil when the registry does not report downloads.
+	Downloads       *uint64
+	DownloadsPeriod string
+	LatestVersion   string
+	// VersionsCount is nil when the registry does not list versions cheaply.
+	VersionsCount *uint32
+}
+
+// Provider maps repositories to packages of a registry and fetches their stats.
+type Provider interface {
+	// Registry returns the identifier the stats are stored under, such as "npm".
+	Registry() string
+	// Languages returns the primary repository languages whose projects are looked up on the registry.
+	Languages() []string
+	// Ecosystem returns the ecosystem of the manifests declaring the packages of the registry.
+	Ecosystem() string
+	// PackageName resolves the package published from the repository, or ErrPackageNotFound.
+	// manifest is the package declared by the manifest of the repository, empty when unknown.
+	PackageName(ctx context.Context, repo *myawesomelistv1.Repository, manifest string) (string, error)
+	// Stats fetches the stats of a package, or ErrPackageNotFound.
+	Stats(ctx context.Context, name string) (*Stats, error)
+}
+
+// GetJSON fetches url with c, waiting on l when set, and decodes the JSON body into v.
+// Not found and gone responses are reported as ErrPackageNotFound.
+func GetJSON(ctx context.Context, c *http.Client, l *rate.Limiter, url string, v any) error {
+	body, err := Get(ctx, c, l, url)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	if err := json.NewDecoder(body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", url, err)
+	}
+	return nil
+}
+
+// Get fetches url with c, waiting on l when set, and returns the body of a successful response.
+// Not found and gone responses are reported as ErrPackageNotFound.
+func Get(ctx context.Context, c *http.Client, l *rate.Limiter, url string) (io.ReadCloser, error) {
+	if l != nil {
+		if err := l.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("rate limiter wait failed: %w", err)
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := c.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request %s failed: %w", url, err)
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		resp.Body.Close()
+		return nil, ErrPackageNotFound
+	case resp.StatusCode >= 300:
+		resp.Body.Close()
+		return nil, fmt.Errorf("request %s failed: %s", url, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// MatchesRepository reports whether a repository URL published in package metadata points to repo.
+// It accepts the usual git+https, git:// and ssh forms, with or without the .git suffix.
+func MatchesRepository(rawURL string, repo *myawesomelistv1.Repository) bool {
+	u := strings.ToLower(strings.TrimSpace(rawURL))
+	u = strings.TrimSuffix(strings.TrimSuffix(u, "/"), ".git")
+	u = strings.Replace(u, "git@"+strings.ToLower(repo.Hostname)+":", strings.ToLower(repo.Hostname)+"/", 1)
+	want := strings.ToLower(repo.Hostname + "/" + repo.Owner + "/" + repo.Repo)
+	if i := strings.Index(u, want); i >= 0 {
+		rest := u[i+len(want):]
+		return rest == "" || strings.HasPrefix(rest, "/") || strings.HasPrefix(rest, "#")
+	}
+	return false
+}
+
+// Client refreshes registry stats of listed projects with a set of providers.
+type Client struct {
+	d         *database.Database
+	providers []Provider
+}
+
+// NewClient constructs a registry Client with the given datastore and providers.
+func NewClient(db *database.Database, providers ...Provider) *Client {
+	return &Client{d: db, providers: providers}
+}
+
+// UpsertAllStaledRegistryStats refreshes, for every provider, the registry stats of listed projects
+// written in one of its languages whose stats are missing or older than ttl (negative disables the TTL).
+// Repositories without a package are stored without one so they are not looked up again before ttl.
+// Failures on a single repository are logged and skipped.
+func (c *Client) UpsertAllStaledRegistryStats(ctx context.Context, ttl time.Duration) error {
+	tracer := otel.Tracer("myawesomelist/registry")
+	ctx, span := tracer.Start(ctx, "Registry.UpsertAllStaledRegistryStats")
+	defer span.End()
+	for _, p := range c.providers {
+		if err := c.upsertStaledRegistryStats(ctx, p, ttl); err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *Client) upsertStaledRegistryStats(ctx context.Context, p Provider, ttl time.Duration) error {
+	tracer := otel.Tracer("myawesomelist/registry")
+	ctx, span := tracer.Start(ctx, "Registry.upsertStaledRegistryStats")
+	span.SetAttributes(attribute.String("registry", p.Registry()))
+	defer span.End()
+	staled, err := c.d.ListStaledProjectRegistryStats(ctx, database.ListStaledProjectRegistryStatsArgs{
+		Registry:  p.Registry(),
+		Ecosystem: p.Ecosystem(),
+		Languages: p.Languages(),
+		TTL:       ttl,
+	})
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("failed to list staled %s registry stats: %w", p.Registry(), err)
+	}
+	span.SetAttributes(attribute.Int("staled", len(staled)))
+	slog.InfoContext(ctx, "Fetching registry stats", "registry", p.Registry(), "count", len(staled))
+	var failed int
+	for _, r := range staled {
+		repo := &myawesomelistv1.Repository{Hostname: r.Hostname, Owner: r.Owner, Repo: r.Repo}
+		args := database.UpsertProjectRegistryStatsArgs{RepositoryID: r.RepositoryID, Registry: p.Registry()}
+		stats, err := lookup(ctx, p, repo, r.ManifestPackage)
+		if err != nil && !errors.Is(err, ErrPackageNotFound) {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			failed++
+			slog.WarnContext(ctx, "Failed to fetch registry stats", "registry", p.Registry(), "hostname", r.Hostname, "owner", r.Owner, "repo", r.Repo, "error", err)
+			continue
+		}
+		if stats != nil {
+			args.Package = stats.Package
+			args.Downloads = stats.Downloads
+			args.DownloadsPeriod = stats.DownloadsPeriod
+			args.LatestVersion = stats.LatestVersion
+			args.VersionsCount = stats.VersionsCount
+		}
+		if err := c.d.UpsertProjectRegistryStats(ctx, args); err != nil {
+			failed++
+			slog.WarnContext(ctx, "Failed to upsert registry stats", "registry", p.Registry(), "hostname", r.Hostname, "owner", r.Owner, "repo", r.Repo, "error", err)
+		}
+	}
+	span.SetAttributes(attribute.Int("failed", failed))
+	slog.InfoContext(ctx, "Fetched registry stats", "registry", p.Registry(), "count", len(staled), "failed", failed)
+	return nil
+}
+
+// lookup resolves the package of repo on the registry of p and fetches its stats.
+func lookup(
+	ctx context.Context,
+	p Provider,
+	repo *myawesomelistv1.Repository,
+	manifest string,
+) (*Stats, error) {
+	name, err := p.PackageName(ctx, repo, manifest)
+	if err != nil {
+		return nil, err
+	}
+	return p.Stats(ctx, name)
+}
@@ -0,0 +1,7 @@
+ALTER TABLE repositories
+    ADD COLUMN IF NOT EXISTS status VARCHAR(32) NOT NULL DEFAULT 'active',
+    ADD COLUMN IF NOT EXISTS status_checked_at TIMESTAMPTZ;
+ALTER TABLE repositories
+    ADD CONSTRAINT repositories_status_check
+    CHECK (status IN ('active', 'archived', 'disabled', 'not_found', 'blocked'));
+CREATE INDEX IF NOT EXISTS idx_repositories_status ON repositories(status);
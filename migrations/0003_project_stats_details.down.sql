@@ -0,0 +1,11 @@
+DROP INDEX IF EXISTS idx_project_stats_pushed_at;
+ALTER TABLE project_stats
+    DROP COLUMN IF EXISTS archived,
+    DROP COLUMN IF EXISTS pushed_at,
+    DROP COLUMN IF EXISTS repository_created_at,
+    DROP COLUMN IF EXISTS default_branch,
+    DROP COLUMN IF EXISTS language,
+    DROP COLUMN IF EXISTS topics,
+    DROP COLUMN IF EXISTS license,
+    DROP COLUMN IF EXISTS subscribers_count,
+    DROP COLUMN IF EXISTS forks_count;
@@ -0,0 +1,11 @@
+ALTER TABLE project_stats
+    ADD COLUMN IF NOT EXISTS forks_count INTEGER,
+    ADD COLUMN IF NOT EXISTS subscribers_count INTEGER,
+    ADD COLUMN IF NOT EXISTS license VARCHAR(64),
+    ADD COLUMN IF NOT EXISTS topics TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS language VARCHAR(100),
+    ADD COLUMN IF NOT EXISTS default_branch VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS repository_created_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS pushed_at TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS archived BOOLEAN NOT NULL DEFAULT FALSE;
+CREATE INDEX IF NOT EXISTS idx_project_stats_pushed_at ON project_stats(pushed_at);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_project_stats_health_score;
+ALTER TABLE project_stats
+    DROP COLUMN IF EXISTS health_scored_at,
+    DROP COLUMN IF EXISTS health_score;
@@ -0,0 +1,4 @@
+ALTER TABLE project_stats
+    ADD COLUMN IF NOT EXISTS contributors_count INTEGER,
+    ADD COLUMN IF NOT EXISTS top_contributor_share DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS bus_factor INTEGER;
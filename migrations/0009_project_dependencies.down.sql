@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS project_dependencies;
+DROP TABLE IF EXISTS project_manifests;
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS project_manifests (
+    id BIGSERIAL PRIMARY KEY,
+    repository_id BIGINT NOT NULL,
+    ecosystem VARCHAR(32) NOT NULL,
+    package VARCHAR(255),
+    dependencies TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (repository_id, ecosystem),
+    FOREIGN KEY (repository_id) REFERENCES repositories(id) ON UPDATE CASCADE ON DELETE RESTRICT
+);
+CREATE INDEX IF NOT EXISTS idx_project_manifests_ecosystem_package
+    ON project_manifests(ecosystem, LOWER(package));
+
+CREATE TABLE IF NOT EXISTS project_dependencies (
+    id BIGSERIAL PRIMARY KEY,
+    repository_id BIGINT NOT NULL,
+    dependency_repository_id BIGINT NOT NULL,
+    ecosystem VARCHAR(32) NOT NULL,
+    package VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (repository_id, dependency_repository_id, ecosystem),
+    FOREIGN KEY (repository_id) REFERENCES repositories(id) ON UPDATE CASCADE ON DELETE CASCADE,
+    FOREIGN KEY (dependency_repository_id) REFERENCES repositories(id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+CREATE INDEX IF NOT EXISTS idx_project_dependencies_dependency_repository_id
+    ON project_dependencies(dependency_repository_id);
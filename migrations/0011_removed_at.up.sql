@@ -0,0 +1,3 @@
+ALTER TABLE categories ADD COLUMN IF NOT EXISTS removed_at TIMESTAMPTZ;
+ALTER TABLE projects ADD COLUMN IF NOT EXISTS removed_at TIMESTAMPTZ;
+CREATE INDEX IF NOT EXISTS idx_projects_listed ON projects(category_id) WHERE removed_at IS NULL;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS collection_history_backfills;
+DROP TABLE IF EXISTS collection_entry_history;
@@ -0,0 +1,23 @@
+CREATE TABLE IF NOT EXISTS collection_entry_history (
+    id BIGSERIAL PRIMARY KEY,
+    collection_id BIGINT NOT NULL,
+    repository_id BIGINT NOT NULL,
+    first_seen_at TIMESTAMPTZ NOT NULL,
+    first_seen_sha VARCHAR(64) NOT NULL,
+    last_seen_at TIMESTAMPTZ NOT NULL,
+    last_seen_sha VARCHAR(64) NOT NULL,
+    UNIQUE (collection_id, repository_id),
+    FOREIGN KEY (collection_id) REFERENCES collections(id) ON DELETE CASCADE,
+    FOREIGN KEY (repository_id) REFERENCES repositories(id) ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS collection_history_backfills (
+    id BIGSERIAL PRIMARY KEY,
+    collection_id BIGINT NOT NULL,
+    last_sha VARCHAR(64) NOT NULL,
+    last_committed_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (collection_id),
+    FOREIGN KEY (collection_id) REFERENCES collections(id) ON DELETE CASCADE
+);
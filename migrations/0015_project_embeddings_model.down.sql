@@ -0,0 +1,10 @@
+DELETE FROM project_embeddings WHERE dimensions IS DISTINCT FROM 3584;
+DELETE FROM project_embeddings pe USING project_embeddings newer
+WHERE newer.project_id = pe.project_id
+AND (newer.updated_at > pe.updated_at OR (newer.updated_at = pe.updated_at AND newer.id > pe.id));
+DROP INDEX IF EXISTS idx_project_embeddings_model;
+ALTER TABLE project_embeddings DROP CONSTRAINT IF EXISTS project_embeddings_project_id_model_key;
+ALTER TABLE project_embeddings ADD CONSTRAINT project_embeddings_project_id_key UNIQUE (project_id);
+ALTER TABLE project_embeddings ALTER COLUMN embedding TYPE vector(3584);
+ALTER TABLE project_embeddings DROP COLUMN IF EXISTS dimensions;
+ALTER TABLE project_embeddings DROP COLUMN IF EXISTS model;
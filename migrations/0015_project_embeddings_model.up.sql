@@ -0,0 +1,10 @@
+-- Embeddings stored before models were recorded keep an empty model until adopted by the
+-- embeddings job of the model that produced them.
+ALTER TABLE project_embeddings ADD COLUMN IF NOT EXISTS model TEXT NOT NULL DEFAULT '';
+ALTER TABLE project_embeddings ADD COLUMN IF NOT EXISTS dimensions INTEGER;
+ALTER TABLE project_embeddings ALTER COLUMN embedding TYPE vector;
+UPDATE project_embeddings SET dimensions = vector_dims(embedding) WHERE embedding IS NOT NULL;
+ALTER TABLE project_embeddings ALTER COLUMN model DROP DEFAULT;
+ALTER TABLE project_embeddings DROP CONSTRAINT IF EXISTS project_embeddings_project_id_key;
+ALTER TABLE project_embeddings ADD CONSTRAINT project_embeddings_project_id_model_key UNIQUE (project_id, model);
+CREATE INDEX IF NOT EXISTS idx_project_embeddings_model ON project_embeddings (model);
@@ -0,0 +1,11 @@
+-- Approximate nearest neighbour indexes are partial expression indexes built per model by
+-- `myawesomelist index rebuild`, since embeddings of different models have different dimensions.
+CREATE TABLE IF NOT EXISTS project_embedding_indexes (
+    name TEXT PRIMARY KEY,
+    model TEXT NOT NULL UNIQUE,
+    dimensions INTEGER NOT NULL,
+    method TEXT NOT NULL,
+    distance TEXT NOT NULL,
+    options TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS query_embeddings (
+    model TEXT NOT NULL,
+    query TEXT NOT NULL,
+    embedding vector NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    used_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (model, query)
+);
+CREATE INDEX IF NOT EXISTS idx_query_embeddings_used_at ON query_embeddings (used_at);
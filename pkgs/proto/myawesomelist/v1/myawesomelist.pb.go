@@ -22,15 +22,323 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// RepositoryStatus reports the availability of a repository on its host
+type RepositoryStatus int32
+
+const (
+	RepositoryStatus_REPOSITORY_STATUS_UNSPECIFIED RepositoryStatus = 0
+	RepositoryStatus_REPOSITORY_STATUS_ACTIVE      RepositoryStatus = 1
+	RepositoryStatus_REPOSITORY_STATUS_ARCHIVED    RepositoryStatus = 2
+	RepositoryStatus_REPOSITORY_STATUS_DISABLED    RepositoryStatus = 3
+	RepositoryStatus_REPOSITORY_STATUS_NOT_FOUND   RepositoryStatus = 4
+	RepositoryStatus_REPOSITORY_STATUS_BLOCKED     RepositoryStatus = 5
+)
+
+// Enum value maps for RepositoryStatus.
+var (
+	RepositoryStatus_name = map[int32]string{
+		0: "REPOSITORY_STATUS_UNSPECIFIED",
+		1: "REPOSITORY_STATUS_ACTIVE",
+		2: "REPOSITORY_STATUS_ARCHIVED",
+		3: "REPOSITORY_STATUS_DISABLED",
+		4: "REPOSITORY_STATUS_NOT_FOUND",
+		5: "REPOSITORY_STATUS_BLOCKED",
+	}
+	RepositoryStatus_value = map[string]int32{
+		"REPOSITORY_STATUS_UNSPECIFIED": 0,
+		"REPOSITORY_STATUS_ACTIVE":      1,
+		"REPOSITORY_STATUS_ARCHIVED":    2,
+		"REPOSITORY_STATUS_DISABLED":    3,
+		"REPOSITORY_STATUS_NOT_FOUND":   4,
+		"REPOSITORY_STATUS_BLOCKED":     5,
+	}
+)
+
+func (x RepositoryStatus) Enum() *RepositoryStatus {
+	p := new(RepositoryStatus)
+	*p = x
+	return p
+}
+
+func (x RepositoryStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RepositoryStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_myawesomelist_v1_myawesomelist_proto_enumTypes[0].Descriptor()
+}
+
+func (RepositoryStatus) Type() protoreflect.EnumType {
+	return &file_myawesomelist_v1_myawesomelist_proto_enumTypes[0]
+}
+
+func (x RepositoryStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RepositoryStatus.Descriptor instead.
+func (RepositoryStatus) EnumDescriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{0}
+}
+
+// StatsInterval selects the bucket size used to downsample a stats time series
+type StatsInterval int32
+
+const (
+	StatsInterval_STATS_INTERVAL_UNSPECIFIED StatsInterval = 0
+	StatsInterval_STATS_INTERVAL_HOUR        StatsInterval = 1
+	StatsInterval_STATS_INTERVAL_DAY         StatsInterval = 2
+	StatsInterval_STATS_INTERVAL_WEEK        StatsInterval = 3
+	StatsInterval_STATS_INTERVAL_MONTH       StatsInterval = 4
+)
+
+// Enum value maps for StatsInterval.
+var (
+	StatsInterval_name = map[int32]string{
+		0: "STATS_INTERVAL_UNSPECIFIED",
+		1: "STATS_INTERVAL_HOUR",
+		2: "STATS_INTERVAL_DAY",
+		3: "STATS_INTERVAL_WEEK",
+		4: "STATS_INTERVAL_MONTH",
+	}
+	StatsInterval_value = map[string]int32{
+		"STATS_INTERVAL_UNSPECIFIED": 0,
+		"STATS_INTERVAL_HOUR":        1,
+		"STATS_INTERVAL_DAY":         2,
+		"STATS_INTERVAL_WEEK":        3,
+		"STATS_INTERVAL_MONTH":       4,
+	}
+)
+
+func (x StatsInterval) Enum() *StatsInterval {
+	p := new(StatsInterval)
+	*p = x
+	return p
+}
+
+func (x StatsInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_myawesomelist_v1_myawesomelist_proto_enumTypes[1].Descriptor()
+}
+
+func (StatsInterval) Type() protoreflect.EnumType {
+	return &file_myawesomelist_v1_myawesomelist_proto_enumTypes[1]
+}
+
+func (x StatsInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsInterval.Descriptor instead.
+func (StatsInterval) EnumDescriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{1}
+}
+
+// TrendingWindow selects the period over which star gains are measured
+type TrendingWindow int32
+
+const (
+	TrendingWindow_TRENDING_WINDOW_UNSPECIFIED TrendingWindow = 0
+	TrendingWindow_TRENDING_WINDOW_DAY         TrendingWindow = 1
+	TrendingWindow_TRENDING_WINDOW_WEEK        TrendingWindow = 2
+	TrendingWindow_TRENDING_WINDOW_MONTH       TrendingWindow = 3
+)
+
+// Enum value maps for TrendingWindow.
+var (
+	TrendingWindow_name = map[int32]string{
+		0: "TRENDING_WINDOW_UNSPECIFIED",
+		1: "TRENDING_WINDOW_DAY",
+		2: "TRENDING_WINDOW_WEEK",
+		3: "TRENDING_WINDOW_MONTH",
+	}
+	TrendingWindow_value = map[string]int32{
+		"TRENDING_WINDOW_UNSPECIFIED": 0,
+		"TRENDING_WINDOW_DAY":         1,
+		"TRENDING_WINDOW_WEEK":        2,
+		"TRENDING_WINDOW_MONTH":       3,
+	}
+)
+
+func (x TrendingWindow) Enum() *TrendingWindow {
+	p := new(TrendingWindow)
+	*p = x
+	return p
+}
+
+func (x TrendingWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrendingWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_myawesomelist_v1_myawesomelist_proto_enumTypes[2].Descriptor()
+}
+
+func (TrendingWindow) Type() protoreflect.EnumType {
+	return &file_myawesomelist_v1_myawesomelist_proto_enumTypes[2]
+}
+
+func (x TrendingWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrendingWindow.Descriptor instead.
+func (TrendingWindow) EnumDescriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{2}
+}
+
+// CollectionEventType is the kind of change recorded for a collection entry
+type CollectionEventType int32
+
+const (
+	CollectionEventType_COLLECTION_EVENT_TYPE_UNSPECIFIED                CollectionEventType = 0
+	CollectionEventType_COLLECTION_EVENT_TYPE_PROJECT_ADDED              CollectionEventType = 1
+	CollectionEventType_COLLECTION_EVENT_TYPE_PROJECT_REMOVED            CollectionEventType = 2
+	CollectionEventType_COLLECTION_EVENT_TYPE_PROJECT_MOVED              CollectionEventType = 3
+	CollectionEventType_COLLECTION_EVENT_TYPE_PROJECT_DESCRIPTION_EDITED CollectionEventType = 4
+)
+
+// Enum value maps for CollectionEventType.
+var (
+	CollectionEventType_name = map[int32]string{
+		0: "COLLECTION_EVENT_TYPE_UNSPECIFIED",
+		1: "COLLECTION_EVENT_TYPE_PROJECT_ADDED",
+		2: "COLLECTION_EVENT_TYPE_PROJECT_REMOVED",
+		3: "COLLECTION_EVENT_TYPE_PROJECT_MOVED",
+		4: "COLLECTION_EVENT_TYPE_PROJECT_DESCRIPTION_EDITED",
+	}
+	CollectionEventType_value = map[string]int32{
+		"COLLECTION_EVENT_TYPE_UNSPECIFIED":                0,
+		"COLLECTION_EVENT_TYPE_PROJECT_ADDED":              1,
+		"COLLECTION_EVENT_TYPE_PROJECT_REMOVED":            2,
+		"COLLECTION_EVENT_TYPE_PROJECT_MOVED":              3,
+		"COLLECTION_EVENT_TYPE_PROJECT_DESCRIPTION_EDITED": 4,
+	}
+)
+
+func (x CollectionEventType) Enum() *CollectionEventType {
+	p := new(CollectionEventType)
+	*p = x
+	return p
+}
+
+func (x CollectionEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CollectionEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_myawesomelist_v1_myawesomelist_proto_enumTypes[3].Descriptor()
+}
+
+func (CollectionEventType) Type() protoreflect.EnumType {
+	return &file_myawesomelist_v1_myawesomelist_proto_enumTypes[3]
+}
+
+func (x CollectionEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CollectionEventType.Descriptor instead.
+func (CollectionEventType) EnumDescriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{3}
+}
+
+// ProjectOrderBy selects how listed projects are sorted
+type ProjectOrderBy int32
+
+const (
+	// Keep the order of the awesome list
+	ProjectOrderBy_PROJECT_ORDER_BY_UNSPECIFIED ProjectOrderBy = 0
+	ProjectOrderBy_PROJECT_ORDER_BY_NAME        ProjectOrderBy = 1
+	// Healthiest first, unscored projects last
+	ProjectOrderBy_PROJECT_ORDER_BY_HEALTH_SCORE ProjectOrderBy = 2
+	// Most depended upon by other catalogued repositories first
+	ProjectOrderBy_PROJECT_ORDER_BY_DEPENDENTS_COUNT ProjectOrderBy = 3
+	// Most recently added to the awesome list first
+	ProjectOrderBy_PROJECT_ORDER_BY_RECENTLY_ADDED ProjectOrderBy = 4
+)
+
+// Enum value maps for ProjectOrderBy.
+var (
+	ProjectOrderBy_name = map[int32]string{
+		0: "PROJECT_ORDER_BY_UNSPECIFIED",
+		1: "PROJECT_ORDER_BY_NAME",
+		2: "PROJECT_ORDER_BY_HEALTH_SCORE",
+		3: "PROJECT_ORDER_BY_DEPENDENTS_COUNT",
+		4: "PROJECT_ORDER_BY_RECENTLY_ADDED",
+	}
+	ProjectOrderBy_value = map[string]int32{
+		"PROJECT_ORDER_BY_UNSPECIFIED":      0,
+		"PROJECT_ORDER_BY_NAME":             1,
+		"PROJECT_ORDER_BY_HEALTH_SCORE":     2,
+		"PROJECT_ORDER_BY_DEPENDENTS_COUNT": 3,
+		"PROJECT_ORDER_BY_RECENTLY_ADDED":   4,
+	}
+)
+
+func (x ProjectOrderBy) Enum() *ProjectOrderBy {
+	p := new(ProjectOrderBy)
+	*p = x
+	return p
+}
+
+func (x ProjectOrderBy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProjectOrderBy) Descriptor() protoreflect.EnumDescriptor {
+	return file_myawesomelist_v1_myawesomelist_proto_enumTypes[4].Descriptor()
+}
+
+func (ProjectOrderBy) Type() protoreflect.EnumType {
+	return &file_myawesomelist_v1_myawesomelist_proto_enumTypes[4]
+}
+
+func (x ProjectOrderBy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProjectOrderBy.Descriptor instead.
+func (ProjectOrderBy) EnumDescriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{4}
+}
+
 // Project represents a single project from an awesome list
 type ProjectStats struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	StargazersCount *uint32                `protobuf:"varint,2,opt,name=stargazers_count,json=stargazersCount,proto3,oneof" json:"stargazers_count,omitempty"`
-	OpenIssueCount  *uint32                `protobuf:"varint,3,opt,name=open_issue_count,json=openIssueCount,proto3,oneof" json:"open_issue_count,omitempty"`
-	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StargazersCount  *uint32                `protobuf:"varint,2,opt,name=stargazers_count,json=stargazersCount,proto3,oneof" json:"stargazers_count,omitempty"`
+	OpenIssueCount   *uint32                `protobuf:"varint,3,opt,name=open_issue_count,json=openIssueCount,proto3,oneof" json:"open_issue_count,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status           RepositoryStatus       `protobuf:"varint,5,opt,name=status,proto3,enum=myawesomelist.v1.RepositoryStatus" json:"status,omitempty"`
+	StatusCheckedAt  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=status_checked_at,json=statusCheckedAt,proto3" json:"status_checked_at,omitempty"`
+	ForksCount       *uint32                `protobuf:"varint,7,opt,name=forks_count,json=forksCount,proto3,oneof" json:"forks_count,omitempty"`
+	SubscribersCount *uint32                `protobuf:"varint,8,opt,name=subscribers_count,json=subscribersCount,proto3,oneof" json:"subscribers_count,omitempty"`
+	// SPDX identifier of the repository license
+	License string   `protobuf:"bytes,9,opt,name=license,proto3" json:"license,omitempty"`
+	Topics  []string `protobuf:"bytes,10,rep,name=topics,proto3" json:"topics,omitempty"`
+	// Primary language reported by the host
+	Language      string                 `protobuf:"bytes,11,opt,name=language,proto3" json:"language,omitempty"`
+	DefaultBranch string                 `protobuf:"bytes,12,opt,name=default_branch,json=defaultBranch,proto3" json:"default_branch,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PushedAt      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=pushed_at,json=pushedAt,proto3" json:"pushed_at,omitempty"`
+	Archived      bool                   `protobuf:"varint,15,opt,name=archived,proto3" json:"archived,omitempty"`
+	LatestRelease *Release               `protobuf:"bytes,16,opt,name=latest_release,json=latestRelease,proto3" json:"latest_release,omitempty"`
+	// Mean number of days between the most recent releases
+	ReleaseCadenceDays *float64 `protobuf:"fixed64,17,opt,name=release_cadence_days,json=releaseCadenceDays,proto3,oneof" json:"release_cadence_days,omitempty"`
+	// Composite health score between 0 and 100, see internal/awesome/health
+	HealthScore       *float64 `protobuf:"fixed64,18,opt,name=health_score,json=healthScore,proto3,oneof" json:"health_score,omitempty"`
+	ContributorsCount *uint32  `protobuf:"varint,19,opt,name=contributors_count,json=contributorsCount,proto3,oneof" json:"contributors_count,omitempty"`
+	// Share of commits authored by the top contributor, between 0 and 1
+	TopContributorShare *float64 `protobuf:"fixed64,20,opt,name=top_contributor_share,json=topContributorShare,proto3,oneof" json:"top_contributor_share,omitempty"`
+	// Smallest number of contributors authoring half of the commits; 1 means a single maintainer
+	BusFactor     *uint32          `protobuf:"varint,21,opt,name=bus_factor,json=busFactor,proto3,oneof" json:"bus_factor,omitempty"`
+	RegistryStats []*RegistryStats `protobuf:"bytes,22,rep,name=registry_stats,json=registryStats,proto3" json:"registry_stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ProjectStats) Reset() {
@@ -58,141 +366,1768 @@ func (x *ProjectStats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProjectStats.ProtoReflect.Descriptor instead.
-func (*ProjectStats) Descriptor() ([]byte, []int) {
-	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ProjectStats.ProtoReflect.Descriptor instead.
+func (*ProjectStats) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ProjectStats) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ProjectStats) GetStargazersCount() uint32 {
+	if x != nil && x.StargazersCount != nil {
+		return *x.StargazersCount
+	}
+	return 0
+}
+
+func (x *ProjectStats) GetOpenIssueCount() uint32 {
+	if x != nil && x.OpenIssueCount != nil {
+		return *x.OpenIssueCount
+	}
+	return 0
+}
+
+func (x *ProjectStats) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *ProjectStats) GetStatus() RepositoryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RepositoryStatus_REPOSITORY_STATUS_UNSPECIFIED
+}
+
+func (x *ProjectStats) GetStatusCheckedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StatusCheckedAt
+	}
+	return nil
+}
+
+func (x *ProjectStats) GetForksCount() uint32 {
+	if x != nil && x.ForksCount != nil {
+		return *x.ForksCount
+	}
+	return 0
+}
+
+func (x *ProjectStats) GetSubscribersCount() uint32 {
+	if x != nil && x.SubscribersCount != nil {
+		return *x.SubscribersCount
+	}
+	return 0
+}
+
+func (x *ProjectStats) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+func (x *ProjectStats) GetTopics() []string {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *ProjectStats) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ProjectStats) GetDefaultBranch() string {
+	if x != nil {
+		return x.DefaultBranch
+	}
+	return ""
+}
+
+func (x *ProjectStats) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ProjectStats) GetPushedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PushedAt
+	}
+	return nil
+}
+
+func (x *ProjectStats) GetArchived() bool {
+	if x != nil {
+		return x.Archived
+	}
+	return false
+}
+
+func (x *ProjectStats) GetLatestRelease() *Release {
+	if x != nil {
+		return x.LatestRelease
+	}
+	return nil
+}
+
+func (x *ProjectStats) GetReleaseCadenceDays() float64 {
+	if x != nil && x.ReleaseCadenceDays != nil {
+		return *x.ReleaseCadenceDays
+	}
+	return 0
+}
+
+func (x *ProjectStats) GetHealthScore() float64 {
+	if x != nil && x.HealthScore != nil {
+		return *x.HealthScore
+	}
+	return 0
+}
+
+func (x *ProjectStats) GetContributorsCount() uint32 {
+	if x != nil && x.ContributorsCount != nil {
+		return *x.ContributorsCount
+	}
+	return 0
+}
+
+func (x *ProjectStats) GetTopContributorShare() float64 {
+	if x != nil && x.TopContributorShare != nil {
+		return *x.TopContributorShare
+	}
+	return 0
+}
+
+func (x *ProjectStats) GetBusFactor() uint32 {
+	if x != nil && x.BusFactor != nil {
+		return *x.BusFactor
+	}
+	return 0
+}
+
+func (x *ProjectStats) GetRegistryStats() []*RegistryStats {
+	if x != nil {
+		return x.RegistryStats
+	}
+	return nil
+}
+
+// RegistryStats holds the popularity of the package published from a repository on a registry
+type RegistryStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Registry identifier: npm, hex or goproxy
+	Registry string `protobuf:"bytes,1,opt,name=registry,proto3" json:"registry,omitempty"`
+	Package  string `protobuf:"bytes,2,opt,name=package,proto3" json:"package,omitempty"`
+	// Downloads over downloads_period, unset when the registry does not report downloads
+	Downloads       *uint64                `protobuf:"varint,3,opt,name=downloads,proto3,oneof" json:"downloads,omitempty"`
+	DownloadsPeriod string                 `protobuf:"bytes,4,opt,name=downloads_period,json=downloadsPeriod,proto3" json:"downloads_period,omitempty"`
+	LatestVersion   string                 `protobuf:"bytes,5,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	VersionsCount   *uint32                `protobuf:"varint,6,opt,name=versions_count,json=versionsCount,proto3,oneof" json:"versions_count,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RegistryStats) Reset() {
+	*x = RegistryStats{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegistryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegistryStats) ProtoMessage() {}
+
+func (x *RegistryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegistryStats.ProtoReflect.Descriptor instead.
+func (*RegistryStats) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RegistryStats) GetRegistry() string {
+	if x != nil {
+		return x.Registry
+	}
+	return ""
+}
+
+func (x *RegistryStats) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+func (x *RegistryStats) GetDownloads() uint64 {
+	if x != nil && x.Downloads != nil {
+		return *x.Downloads
+	}
+	return 0
+}
+
+func (x *RegistryStats) GetDownloadsPeriod() string {
+	if x != nil {
+		return x.DownloadsPeriod
+	}
+	return ""
+}
+
+func (x *RegistryStats) GetLatestVersion() string {
+	if x != nil {
+		return x.LatestVersion
+	}
+	return ""
+}
+
+func (x *RegistryStats) GetVersionsCount() uint32 {
+	if x != nil && x.VersionsCount != nil {
+		return *x.VersionsCount
+	}
+	return 0
+}
+
+func (x *RegistryStats) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Release is a published release of a repository
+type Release struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TagName       string                 `protobuf:"bytes,1,opt,name=tag_name,json=tagName,proto3" json:"tag_name,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PublishedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=published_at,json=publishedAt,proto3" json:"published_at,omitempty"`
+	Prerelease    bool                   `protobuf:"varint,4,opt,name=prerelease,proto3" json:"prerelease,omitempty"`
+	Url           string                 `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Release) Reset() {
+	*x = Release{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Release) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Release) ProtoMessage() {}
+
+func (x *Release) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Release.ProtoReflect.Descriptor instead.
+func (*Release) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Release) GetTagName() string {
+	if x != nil {
+		return x.TagName
+	}
+	return ""
+}
+
+func (x *Release) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Release) GetPublishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return nil
+}
+
+func (x *Release) GetPrerelease() bool {
+	if x != nil {
+		return x.Prerelease
+	}
+	return false
+}
+
+func (x *Release) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+// ProjectStatsPoint is a snapshot of repository counters at a point in time
+type ProjectStatsPoint struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RecordedAt       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	StargazersCount  *uint32                `protobuf:"varint,2,opt,name=stargazers_count,json=stargazersCount,proto3,oneof" json:"stargazers_count,omitempty"`
+	OpenIssueCount   *uint32                `protobuf:"varint,3,opt,name=open_issue_count,json=openIssueCount,proto3,oneof" json:"open_issue_count,omitempty"`
+	ForksCount       *uint32                `protobuf:"varint,4,opt,name=forks_count,json=forksCount,proto3,oneof" json:"forks_count,omitempty"`
+	SubscribersCount *uint32                `protobuf:"varint,5,opt,name=subscribers_count,json=subscribersCount,proto3,oneof" json:"subscribers_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ProjectStatsPoint) Reset() {
+	*x = ProjectStatsPoint{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProjectStatsPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectStatsPoint) ProtoMessage() {}
+
+func (x *ProjectStatsPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectStatsPoint.ProtoReflect.Descriptor instead.
+func (*ProjectStatsPoint) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProjectStatsPoint) GetRecordedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return nil
+}
+
+func (x *ProjectStatsPoint) GetStargazersCount() uint32 {
+	if x != nil && x.StargazersCount != nil {
+		return *x.StargazersCount
+	}
+	return 0
+}
+
+func (x *ProjectStatsPoint) GetOpenIssueCount() uint32 {
+	if x != nil && x.OpenIssueCount != nil {
+		return *x.OpenIssueCount
+	}
+	return 0
+}
+
+func (x *ProjectStatsPoint) GetForksCount() uint32 {
+	if x != nil && x.ForksCount != nil {
+		return *x.ForksCount
+	}
+	return 0
+}
+
+func (x *ProjectStatsPoint) GetSubscribersCount() uint32 {
+	if x != nil && x.SubscribersCount != nil {
+		return *x.SubscribersCount
+	}
+	return 0
+}
+
+type Project struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Repo          *Repository            `protobuf:"bytes,4,opt,name=repo,proto3" json:"repo,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Status        RepositoryStatus       `protobuf:"varint,6,opt,name=status,proto3,enum=myawesomelist.v1.RepositoryStatus" json:"status,omitempty"`
+	LatestRelease *Release               `protobuf:"bytes,7,opt,name=latest_release,json=latestRelease,proto3" json:"latest_release,omitempty"`
+	// Composite health score between 0 and 100, unset until scored
+	HealthScore *float64 `protobuf:"fixed64,8,opt,name=health_score,json=healthScore,proto3,oneof" json:"health_score,omitempty"`
+	// Number of catalogued repositories depending on this project
+	DependentsCount uint32 `protobuf:"varint,9,opt,name=dependents_count,json=dependentsCount,proto3" json:"dependents_count,omitempty"`
+	// Set when the entry was dropped from its collection README
+	RemovedAt *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	// When the entry first appeared in the collection README, set in collection listings.
+	// Falls back to the first ingest until the README history is backfilled.
+	FirstSeenAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=first_seen_at,json=firstSeenAt,proto3" json:"first_seen_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Project) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Project) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Project) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Project) GetRepo() *Repository {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Project) GetStatus() RepositoryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return RepositoryStatus_REPOSITORY_STATUS_UNSPECIFIED
+}
+
+func (x *Project) GetLatestRelease() *Release {
+	if x != nil {
+		return x.LatestRelease
+	}
+	return nil
+}
+
+func (x *Project) GetHealthScore() float64 {
+	if x != nil && x.HealthScore != nil {
+		return *x.HealthScore
+	}
+	return 0
+}
+
+func (x *Project) GetDependentsCount() uint32 {
+	if x != nil {
+		return x.DependentsCount
+	}
+	return 0
+}
+
+func (x *Project) GetRemovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemovedAt
+	}
+	return nil
+}
+
+func (x *Project) GetFirstSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeenAt
+	}
+	return nil
+}
+
+// DependencyEdge links a catalogued repository to another one through a package dependency
+type DependencyEdge struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Repo  *Repository            `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Ecosystem of the manifest declaring the dependency: go, npm or hex
+	Ecosystem string `protobuf:"bytes,2,opt,name=ecosystem,proto3" json:"ecosystem,omitempty"`
+	// Name of the package the dependency was declared on
+	Package       string `protobuf:"bytes,3,opt,name=package,proto3" json:"package,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DependencyEdge) Reset() {
+	*x = DependencyEdge{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DependencyEdge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DependencyEdge) ProtoMessage() {}
+
+func (x *DependencyEdge) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DependencyEdge.ProtoReflect.Descriptor instead.
+func (*DependencyEdge) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DependencyEdge) GetRepo() *Repository {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *DependencyEdge) GetEcosystem() string {
+	if x != nil {
+		return x.Ecosystem
+	}
+	return ""
+}
+
+func (x *DependencyEdge) GetPackage() string {
+	if x != nil {
+		return x.Package
+	}
+	return ""
+}
+
+// TrendingProject is a project ranked by its star gain over a window
+type TrendingProject struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Project         *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	StargazersCount *uint32                `protobuf:"varint,2,opt,name=stargazers_count,json=stargazersCount,proto3,oneof" json:"stargazers_count,omitempty"`
+	// Stars gained between the start of the window and the latest snapshot
+	StargazersDelta int32 `protobuf:"varint,3,opt,name=stargazers_delta,json=stargazersDelta,proto3" json:"stargazers_delta,omitempty"`
+	// Star gain damped by the square root of the starting star count
+	Score         float64 `protobuf:"fixed64,4,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrendingProject) Reset() {
+	*x = TrendingProject{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrendingProject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrendingProject) ProtoMessage() {}
+
+func (x *TrendingProject) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrendingProject.ProtoReflect.Descriptor instead.
+func (*TrendingProject) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TrendingProject) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *TrendingProject) GetStargazersCount() uint32 {
+	if x != nil && x.StargazersCount != nil {
+		return *x.StargazersCount
+	}
+	return 0
+}
+
+func (x *TrendingProject) GetStargazersDelta() int32 {
+	if x != nil {
+		return x.StargazersDelta
+	}
+	return 0
+}
+
+func (x *TrendingProject) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// SimilarProject is a project ranked by the distance of its embedding to another project
+type SimilarProject struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Project *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	// Distance between the embeddings; lower is more similar
+	Distance float64 `protobuf:"fixed64,2,opt,name=distance,proto3" json:"distance,omitempty"`
+	// Language of the collection the project is listed in
+	Language      string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilarProject) Reset() {
+	*x = SimilarProject{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilarProject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarProject) ProtoMessage() {}
+
+func (x *SimilarProject) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarProject.ProtoReflect.Descriptor instead.
+func (*SimilarProject) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SimilarProject) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+func (x *SimilarProject) GetDistance() float64 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *SimilarProject) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+// Category groups projects under a section
+type Category struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Projects  []*Project             `protobuf:"bytes,3,rep,name=projects,proto3" json:"projects,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Set when the section was dropped from its collection README
+	RemovedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=removed_at,json=removedAt,proto3" json:"removed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Category) Reset() {
+	*x = Category{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Category) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Category) ProtoMessage() {}
+
+func (x *Category) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Category.ProtoReflect.Descriptor instead.
+func (*Category) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Category) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Category) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Category) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Category) GetRemovedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RemovedAt
+	}
+	return nil
+}
+
+// Collection represents an awesome repository parsed into categories
+type Collection struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
+	Repo          *Repository            `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`
+	Categories    []*Category            `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Collection) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Collection) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *Collection) GetRepo() *Repository {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *Collection) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *Collection) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// CollectionEvent records a change to a collection entry detected between two ingests
+type CollectionEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type  CollectionEventType    `protobuf:"varint,2,opt,name=type,proto3,enum=myawesomelist.v1.CollectionEventType" json:"type,omitempty"`
+	// Repository of the collection the entry is listed in
+	CollectionRepo *Repository `protobuf:"bytes,3,opt,name=collection_repo,json=collectionRepo,proto3" json:"collection_repo,omitempty"`
+	// Repository of the listed project
+	ProjectRepo  *Repository `protobuf:"bytes,4,opt,name=project_repo,json=projectRepo,proto3" json:"project_repo,omitempty"`
+	ProjectName  string      `protobuf:"bytes,5,opt,name=project_name,json=projectName,proto3" json:"project_name,omitempty"`
+	CategoryName string      `protobuf:"bytes,6,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	// Category the entry was listed under before a move
+	PreviousCategoryName string `protobuf:"bytes,7,opt,name=previous_category_name,json=previousCategoryName,proto3" json:"previous_category_name,omitempty"`
+	Description          string `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	// Description of the entry before an edit
+	PreviousDescription string                 `protobuf:"bytes,9,opt,name=previous_description,json=previousDescription,proto3" json:"previous_description,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CollectionEvent) Reset() {
+	*x = CollectionEvent{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CollectionEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CollectionEvent) ProtoMessage() {}
+
+func (x *CollectionEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CollectionEvent.ProtoReflect.Descriptor instead.
+func (*CollectionEvent) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *CollectionEvent) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CollectionEvent) GetType() CollectionEventType {
+	if x != nil {
+		return x.Type
+	}
+	return CollectionEventType_COLLECTION_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *CollectionEvent) GetCollectionRepo() *Repository {
+	if x != nil {
+		return x.CollectionRepo
+	}
+	return nil
+}
+
+func (x *CollectionEvent) GetProjectRepo() *Repository {
+	if x != nil {
+		return x.ProjectRepo
+	}
+	return nil
+}
+
+func (x *CollectionEvent) GetProjectName() string {
+	if x != nil {
+		return x.ProjectName
+	}
+	return ""
+}
+
+func (x *CollectionEvent) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *CollectionEvent) GetPreviousCategoryName() string {
+	if x != nil {
+		return x.PreviousCategoryName
+	}
+	return ""
+}
+
+func (x *CollectionEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CollectionEvent) GetPreviousDescription() string {
+	if x != nil {
+		return x.PreviousDescription
+	}
+	return ""
+}
+
+func (x *CollectionEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Identify a source awesome repository (owner/repo)
+type Repository struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hostname      string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Owner         string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Repo          string                 `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Repository) Reset() {
+	*x = Repository{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Repository) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Repository) ProtoMessage() {}
+
+func (x *Repository) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Repository.ProtoReflect.Descriptor instead.
+func (*Repository) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Repository) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *Repository) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *Repository) GetRepo() string {
+	if x != nil {
+		return x.Repo
+	}
+	return ""
+}
+
+type ListCollectionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Repos []*Repository          `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
+	// Maximum number of collections to return; the server picks a default when unset
+	PageSize uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page, or empty for the first page
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsRequest) Reset() {
+	*x = ListCollectionsRequest{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsRequest) ProtoMessage() {}
+
+func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListCollectionsRequest) GetRepos() []*Repository {
+	if x != nil {
+		return x.Repos
+	}
+	return nil
+}
+
+func (x *ListCollectionsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCollectionsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCollectionsResponse struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Collections []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	// Token of the next page, or empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Number of collections across all pages
+	TotalSize     uint32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCollectionsResponse) Reset() {
+	*x = ListCollectionsResponse{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsResponse) ProtoMessage() {}
+
+func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+func (x *ListCollectionsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListCollectionsResponse) GetTotalSize() uint32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type GetCollectionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Repo  *Repository            `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Also return categories and projects dropped from the README
+	IncludeRemoved bool `protobuf:"varint,2,opt,name=include_removed,json=includeRemoved,proto3" json:"include_removed,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetCollectionRequest) Reset() {
+	*x = GetCollectionRequest{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionRequest) ProtoMessage() {}
+
+func (x *GetCollectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionRequest.ProtoReflect.Descriptor instead.
+func (*GetCollectionRequest) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetCollectionRequest) GetRepo() *Repository {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *GetCollectionRequest) GetIncludeRemoved() bool {
+	if x != nil {
+		return x.IncludeRemoved
+	}
+	return false
+}
+
+type GetCollectionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCollectionResponse) Reset() {
+	*x = GetCollectionResponse{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCollectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCollectionResponse) ProtoMessage() {}
+
+func (x *GetCollectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCollectionResponse.ProtoReflect.Descriptor instead.
+func (*GetCollectionResponse) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCollectionResponse) GetCollection() *Collection {
+	if x != nil {
+		return x.Collection
+	}
+	return nil
+}
+
+type ListCategoriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Repo  *Repository            `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Maximum number of categories to return; the server picks a default when unset
+	PageSize uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page, or empty for the first page
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListCategoriesRequest) GetRepo() *Repository {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *ListCategoriesRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListCategoriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListCategoriesResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Categories []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	// Token of the next page, or empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Number of categories across all pages
+	TotalSize     uint32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *ListCategoriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListCategoriesResponse) GetTotalSize() uint32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type ListProjectsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Repo         *Repository            `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	CategoryName string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	OrderBy      ProjectOrderBy         `protobuf:"varint,3,opt,name=order_by,json=orderBy,proto3,enum=myawesomelist.v1.ProjectOrderBy" json:"order_by,omitempty"`
+	// Maximum number of projects to return; the server picks a default when unset
+	PageSize uint32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page, or empty for the first page
+	PageToken     string `protobuf:"bytes,5,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsRequest) Reset() {
+	*x = ListProjectsRequest{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsRequest) ProtoMessage() {}
+
+func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListProjectsRequest) GetRepo() *Repository {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *ListProjectsRequest) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *ListProjectsRequest) GetOrderBy() ProjectOrderBy {
+	if x != nil {
+		return x.OrderBy
+	}
+	return ProjectOrderBy_PROJECT_ORDER_BY_UNSPECIFIED
+}
+
+func (x *ListProjectsRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListProjectsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListProjectsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Projects []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	// Token of the next page, or empty on the last page
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Number of projects across all pages
+	TotalSize     uint32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsResponse) Reset() {
+	*x = ListProjectsResponse{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsResponse) ProtoMessage() {}
+
+func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListProjectsResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *ListProjectsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListProjectsResponse) GetTotalSize() uint32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+type SearchProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Query string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Deprecated: use page_size
+	Limit uint32        `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Repos []*Repository `protobuf:"bytes,3,rep,name=repos,proto3" json:"repos,omitempty"`
+	// Also match projects dropped from their collection README
+	IncludeRemoved bool `protobuf:"varint,4,opt,name=include_removed,json=includeRemoved,proto3" json:"include_removed,omitempty"`
+	// Weight of the embedding similarity ranking in the fused ranking, 1 when unset, 0 disables it
+	SemanticWeight *float64 `protobuf:"fixed64,5,opt,name=semantic_weight,json=semanticWeight,proto3,oneof" json:"semantic_weight,omitempty"`
+	// Weight of the full-text ranking over name and description in the fused ranking, 1 when unset, 0 disables it
+	LexicalWeight *float64 `protobuf:"fixed64,6,opt,name=lexical_weight,json=lexicalWeight,proto3,oneof" json:"lexical_weight,omitempty"`
+	// Only match projects of collections in this language, case-insensitive
+	Language string `protobuf:"bytes,7,opt,name=language,proto3" json:"language,omitempty"`
+	// Only match projects listed under this category name, case-insensitive
+	Category string `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	// Only match projects with at least this many stars
+	MinStars *uint32 `protobuf:"varint,9,opt,name=min_stars,json=minStars,proto3,oneof" json:"min_stars,omitempty"`
+	// Only match projects with at most this many stars
+	MaxStars *uint32 `protobuf:"varint,10,opt,name=max_stars,json=maxStars,proto3,oneof" json:"max_stars,omitempty"`
+	// Only match projects pushed to after this time
+	PushedAfter *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=pushed_after,json=pushedAfter,proto3" json:"pushed_after,omitempty"`
+	// Only match projects under this license SPDX identifier, case-insensitive
+	License string `protobuf:"bytes,12,opt,name=license,proto3" json:"license,omitempty"`
+	// Only match projects hosted on this hostname
+	Host string `protobuf:"bytes,13,opt,name=host,proto3" json:"host,omitempty"`
+	// Skip archived repositories
+	ExcludeArchived bool `protobuf:"varint,14,opt,name=exclude_archived,json=excludeArchived,proto3" json:"exclude_archived,omitempty"`
+	// Maximum number of projects to return; limit or a server default when unset
+	PageSize uint32 `protobuf:"varint,15,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// next_page_token of the previous page, or empty for the first page
+	PageToken     string `protobuf:"bytes,16,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProjectsRequest) Reset() {
+	*x = SearchProjectsRequest{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProjectsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProjectsRequest) ProtoMessage() {}
+
+func (x *SearchProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProjectsRequest.ProtoReflect.Descriptor instead.
+func (*SearchProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ProjectStats) GetId() uint64 {
+func (x *SearchProjectsRequest) GetQuery() string {
 	if x != nil {
-		return x.Id
+		return x.Query
 	}
-	return 0
+	return ""
 }
 
-func (x *ProjectStats) GetStargazersCount() uint32 {
-	if x != nil && x.StargazersCount != nil {
-		return *x.StargazersCount
+func (x *SearchProjectsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
 	}
 	return 0
 }
 
-func (x *ProjectStats) GetOpenIssueCount() uint32 {
-	if x != nil && x.OpenIssueCount != nil {
-		return *x.OpenIssueCount
+func (x *SearchProjectsRequest) GetRepos() []*Repository {
+	if x != nil {
+		return x.Repos
 	}
-	return 0
+	return nil
 }
 
-func (x *ProjectStats) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *SearchProjectsRequest) GetIncludeRemoved() bool {
 	if x != nil {
-		return x.UpdatedAt
+		return x.IncludeRemoved
 	}
-	return nil
+	return false
 }
 
-type Project struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Repo          *Repository            `protobuf:"bytes,4,opt,name=repo,proto3" json:"repo,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SearchProjectsRequest) GetSemanticWeight() float64 {
+	if x != nil && x.SemanticWeight != nil {
+		return *x.SemanticWeight
+	}
+	return 0
 }
 
-func (x *Project) Reset() {
-	*x = Project{}
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *SearchProjectsRequest) GetLexicalWeight() float64 {
+	if x != nil && x.LexicalWeight != nil {
+		return *x.LexicalWeight
+	}
+	return 0
 }
 
-func (x *Project) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SearchProjectsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
 }
 
-func (*Project) ProtoMessage() {}
-
-func (x *Project) ProtoReflect() protoreflect.Message {
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[1]
+func (x *SearchProjectsRequest) GetCategory() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Category
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Project.ProtoReflect.Descriptor instead.
-func (*Project) Descriptor() ([]byte, []int) {
-	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{1}
+func (x *SearchProjectsRequest) GetMinStars() uint32 {
+	if x != nil && x.MinStars != nil {
+		return *x.MinStars
+	}
+	return 0
 }
 
-func (x *Project) GetId() uint64 {
-	if x != nil {
-		return x.Id
+func (x *SearchProjectsRequest) GetMaxStars() uint32 {
+	if x != nil && x.MaxStars != nil {
+		return *x.MaxStars
 	}
 	return 0
 }
 
-func (x *Project) GetName() string {
+func (x *SearchProjectsRequest) GetPushedAfter() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Name
+		return x.PushedAfter
+	}
+	return nil
+}
+
+func (x *SearchProjectsRequest) GetLicense() string {
+	if x != nil {
+		return x.License
 	}
 	return ""
 }
 
-func (x *Project) GetDescription() string {
+func (x *SearchProjectsRequest) GetHost() string {
 	if x != nil {
-		return x.Description
+		return x.Host
 	}
 	return ""
 }
 
-func (x *Project) GetRepo() *Repository {
+func (x *SearchProjectsRequest) GetExcludeArchived() bool {
 	if x != nil {
-		return x.Repo
+		return x.ExcludeArchived
 	}
-	return nil
+	return false
 }
 
-func (x *Project) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *SearchProjectsRequest) GetPageSize() uint32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-// Category groups projects under a section
-type Category struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Projects      []*Project             `protobuf:"bytes,3,rep,name=projects,proto3" json:"projects,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+func (x *SearchProjectsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type SearchProjectsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Projects ranked by fusing the 100 best matches of the embedding ranking with the 100 best
+	// matches of the full-text ranking, so a search returns at most 200 projects
+	Projects []*Project `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	// Token of the next page, or empty on the last page of the ranked window
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Number of projects across all pages, which is the size of the ranked window rather than the number of matching projects
+	TotalSize     uint32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Category) Reset() {
-	*x = Category{}
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[2]
+func (x *SearchProjectsResponse) Reset() {
+	*x = SearchProjectsResponse{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Category) String() string {
+func (x *SearchProjectsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Category) ProtoMessage() {}
+func (*SearchProjectsResponse) ProtoMessage() {}
 
-func (x *Category) ProtoReflect() protoreflect.Message {
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[2]
+func (x *SearchProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -203,66 +2138,54 @@ func (x *Category) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Category.ProtoReflect.Descriptor instead.
-func (*Category) Descriptor() ([]byte, []int) {
-	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use SearchProjectsResponse.ProtoReflect.Descriptor instead.
+func (*SearchProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *Category) GetId() uint64 {
+func (x *SearchProjectsResponse) GetProjects() []*Project {
 	if x != nil {
-		return x.Id
+		return x.Projects
 	}
-	return 0
+	return nil
 }
 
-func (x *Category) GetName() string {
+func (x *SearchProjectsResponse) GetNextPageToken() string {
 	if x != nil {
-		return x.Name
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *Category) GetProjects() []*Project {
-	if x != nil {
-		return x.Projects
-	}
-	return nil
-}
-
-func (x *Category) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *SearchProjectsResponse) GetTotalSize() uint32 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.TotalSize
 	}
-	return nil
+	return 0
 }
 
-// Collection represents an awesome repository parsed into categories
-type Collection struct {
+type GetProjectStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Language      string                 `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
-	Repo          *Repository            `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`
-	Categories    []*Category            `protobuf:"bytes,4,rep,name=categories,proto3" json:"categories,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Repo          *Repository            `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Collection) Reset() {
-	*x = Collection{}
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[3]
+func (x *GetProjectStatsRequest) Reset() {
+	*x = GetProjectStatsRequest{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Collection) String() string {
+func (x *GetProjectStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Collection) ProtoMessage() {}
+func (*GetProjectStatsRequest) ProtoMessage() {}
 
-func (x *Collection) ProtoReflect() protoreflect.Message {
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[3]
+func (x *GetProjectStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -273,71 +2196,40 @@ func (x *Collection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
-func (*Collection) Descriptor() ([]byte, []int) {
-	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{3}
-}
-
-func (x *Collection) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Collection) GetLanguage() string {
-	if x != nil {
-		return x.Language
-	}
-	return ""
+// Deprecated: Use GetProjectStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectStatsRequest) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Collection) GetRepo() *Repository {
+func (x *GetProjectStatsRequest) GetRepo() *Repository {
 	if x != nil {
 		return x.Repo
 	}
 	return nil
 }
 
-func (x *Collection) GetCategories() []*Category {
-	if x != nil {
-		return x.Categories
-	}
-	return nil
-}
-
-func (x *Collection) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
-}
-
-// Identify a source awesome repository (owner/repo)
-type Repository struct {
+type GetProjectStatsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Hostname      string                 `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
-	Owner         string                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	Repo          string                 `protobuf:"bytes,3,opt,name=repo,proto3" json:"repo,omitempty"`
+	Stats         *ProjectStats          `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Repository) Reset() {
-	*x = Repository{}
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[4]
+func (x *GetProjectStatsResponse) Reset() {
+	*x = GetProjectStatsResponse{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Repository) String() string {
+func (x *GetProjectStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Repository) ProtoMessage() {}
+func (*GetProjectStatsResponse) ProtoMessage() {}
 
-func (x *Repository) ProtoReflect() protoreflect.Message {
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[4]
+func (x *GetProjectStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -348,54 +2240,46 @@ func (x *Repository) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Repository.ProtoReflect.Descriptor instead.
-func (*Repository) Descriptor() ([]byte, []int) {
-	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *Repository) GetHostname() string {
-	if x != nil {
-		return x.Hostname
-	}
-	return ""
-}
-
-func (x *Repository) GetOwner() string {
-	if x != nil {
-		return x.Owner
-	}
-	return ""
+// Deprecated: Use GetProjectStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectStatsResponse) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Repository) GetRepo() string {
+func (x *GetProjectStatsResponse) GetStats() *ProjectStats {
 	if x != nil {
-		return x.Repo
+		return x.Stats
 	}
-	return ""
+	return nil
 }
 
-type ListCollectionsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Repos         []*Repository          `protobuf:"bytes,1,rep,name=repos,proto3" json:"repos,omitempty"`
+type GetProjectStatsHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Repo  *Repository            `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Inclusive lower bound; unset means since the first recorded point
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Exclusive upper bound; unset means up to now
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Keep the last point of each bucket; unspecified returns every point
+	Interval      StatsInterval `protobuf:"varint,4,opt,name=interval,proto3,enum=myawesomelist.v1.StatsInterval" json:"interval,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCollectionsRequest) Reset() {
-	*x = ListCollectionsRequest{}
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[5]
+func (x *GetProjectStatsHistoryRequest) Reset() {
+	*x = GetProjectStatsHistoryRequest{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCollectionsRequest) String() string {
+func (x *GetProjectStatsHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCollectionsRequest) ProtoMessage() {}
+func (*GetProjectStatsHistoryRequest) ProtoMessage() {}
 
-func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[5]
+func (x *GetProjectStatsHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,40 +2290,61 @@ func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
-func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
-	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetProjectStatsHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectStatsHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetProjectStatsHistoryRequest) GetRepo() *Repository {
+	if x != nil {
+		return x.Repo
+	}
+	return nil
+}
+
+func (x *GetProjectStatsHistoryRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *GetProjectStatsHistoryRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
 }
 
-func (x *ListCollectionsRequest) GetRepos() []*Repository {
+func (x *GetProjectStatsHistoryRequest) GetInterval() StatsInterval {
 	if x != nil {
-		return x.Repos
+		return x.Interval
 	}
-	return nil
+	return StatsInterval_STATS_INTERVAL_UNSPECIFIED
 }
 
-type ListCollectionsResponse struct {
+type GetProjectStatsHistoryResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Collections   []*Collection          `protobuf:"bytes,1,rep,name=collections,proto3" json:"collections,omitempty"`
+	Points        []*ProjectStatsPoint   `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCollectionsResponse) Reset() {
-	*x = ListCollectionsResponse{}
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[6]
+func (x *GetProjectStatsHistoryResponse) Reset() {
+	*x = GetProjectStatsHistoryResponse{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCollectionsResponse) String() string {
+func (x *GetProjectStatsHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCollectionsResponse) ProtoMessage() {}
+func (*GetProjectStatsHistoryResponse) ProtoMessage() {}
 
-func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[6]
+func (x *GetProjectStatsHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,40 +2355,48 @@ func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCollectionsResponse.ProtoReflect.Descriptor instead.
-func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
-	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetProjectStatsHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectStatsHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ListCollectionsResponse) GetCollections() []*Collection {
+func (x *GetProjectStatsHistoryResponse) GetPoints() []*ProjectStatsPoint {
 	if x != nil {
-		return x.Collections
+		return x.Points
 	}
 	return nil
 }
 
-type GetCollectionRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Repo          *Repository            `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+type ListTrendingProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Defaults to a week when unspecified
+	Window TrendingWindow `protobuf:"varint,1,opt,name=window,proto3,enum=myawesomelist.v1.TrendingWindow" json:"window,omitempty"`
+	// Restrict to projects listed in this collection
+	Repo *Repository `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Restrict to projects listed under this category name
+	CategoryName string `protobuf:"bytes,3,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	// Restrict to collections of this language (case-insensitive)
+	Language      string `protobuf:"bytes,4,opt,name=language,proto3" json:"language,omitempty"`
+	Limit         uint32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCollectionRequest) Reset() {
-	*x = GetCollectionRequest{}
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[7]
+func (x *ListTrendingProjectsRequest) Reset() {
+	*x = ListTrendingProjectsRequest{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollectionRequest) String() string {
+func (x *ListTrendingProjectsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollectionRequest) ProtoMessage() {}
+func (*ListTrendingProjectsRequest) ProtoMessage() {}
 
-func (x *GetCollectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[7]
+func (x *ListTrendingProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -494,40 +2407,68 @@ func (x *GetCollectionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollectionRequest.ProtoReflect.Descriptor instead.
-func (*GetCollectionRequest) Descriptor() ([]byte, []int) {
-	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use ListTrendingProjectsRequest.ProtoReflect.Descriptor instead.
+func (*ListTrendingProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *GetCollectionRequest) GetRepo() *Repository {
+func (x *ListTrendingProjectsRequest) GetWindow() TrendingWindow {
+	if x != nil {
+		return x.Window
+	}
+	return TrendingWindow_TRENDING_WINDOW_UNSPECIFIED
+}
+
+func (x *ListTrendingProjectsRequest) GetRepo() *Repository {
 	if x != nil {
 		return x.Repo
 	}
 	return nil
 }
 
-type GetCollectionResponse struct {
+func (x *ListTrendingProjectsRequest) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *ListTrendingProjectsRequest) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *ListTrendingProjectsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListTrendingProjectsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Collection    *Collection            `protobuf:"bytes,1,opt,name=collection,proto3" json:"collection,omitempty"`
+	Projects      []*TrendingProject     `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCollectionResponse) Reset() {
-	*x = GetCollectionResponse{}
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[8]
+func (x *ListTrendingProjectsResponse) Reset() {
+	*x = ListTrendingProjectsResponse{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCollectionResponse) String() string {
+func (x *ListTrendingProjectsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCollectionResponse) ProtoMessage() {}
+func (*ListTrendingProjectsResponse) ProtoMessage() {}
 
-func (x *GetCollectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[8]
+func (x *ListTrendingProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -538,40 +2479,46 @@ func (x *GetCollectionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCollectionResponse.ProtoReflect.Descriptor instead.
-func (*GetCollectionResponse) Descriptor() ([]byte, []int) {
-	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use ListTrendingProjectsResponse.ProtoReflect.Descriptor instead.
+func (*ListTrendingProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GetCollectionResponse) GetCollection() *Collection {
+func (x *ListTrendingProjectsResponse) GetProjects() []*TrendingProject {
 	if x != nil {
-		return x.Collection
+		return x.Projects
 	}
 	return nil
 }
 
-type ListCategoriesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Repo          *Repository            `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+type ListCollectionChangesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Restrict to changes of this collection
+	Repo *Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Inclusive lower bound; unset means since the first recorded change
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Exclusive upper bound; unset means up to now
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Limit         uint32                 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCategoriesRequest) Reset() {
-	*x = ListCategoriesRequest{}
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[9]
+func (x *ListCollectionChangesRequest) Reset() {
+	*x = ListCollectionChangesRequest{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCategoriesRequest) String() string {
+func (x *ListCollectionChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCategoriesRequest) ProtoMessage() {}
+func (*ListCollectionChangesRequest) ProtoMessage() {}
 
-func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[9]
+func (x *ListCollectionChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,40 +2529,62 @@ func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
-func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
-	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListCollectionChangesRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionChangesRequest) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ListCategoriesRequest) GetRepo() *Repository {
+func (x *ListCollectionChangesRequest) GetRepo() *Repository {
 	if x != nil {
 		return x.Repo
 	}
 	return nil
 }
 
-type ListCategoriesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Categories    []*Category            `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+func (x *ListCollectionChangesRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListCollectionChangesRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListCollectionChangesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListCollectionChangesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Most recent changes first
+	Events        []*CollectionEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCategoriesResponse) Reset() {
-	*x = ListCategoriesResponse{}
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[10]
+func (x *ListCollectionChangesResponse) Reset() {
+	*x = ListCollectionChangesResponse{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCategoriesResponse) String() string {
+func (x *ListCollectionChangesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCategoriesResponse) ProtoMessage() {}
+func (*ListCollectionChangesResponse) ProtoMessage() {}
 
-func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[10]
+func (x *ListCollectionChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -626,41 +2595,48 @@ func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
-func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
-	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ListCollectionChangesResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionChangesResponse) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ListCategoriesResponse) GetCategories() []*Category {
+func (x *ListCollectionChangesResponse) GetEvents() []*CollectionEvent {
 	if x != nil {
-		return x.Categories
+		return x.Events
 	}
 	return nil
 }
 
-type ListProjectsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Repo          *Repository            `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
-	CategoryName  string                 `protobuf:"bytes,2,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+type FindSimilarProjectsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Project to find neighbours of; takes precedence over repo
+	ProjectId uint64 `protobuf:"varint,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// Repository to find neighbours of, averaging its embeddings across the collections listing it
+	Repo *Repository `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	// Only return projects listed in other collections than the source project
+	OtherCollectionsOnly bool `protobuf:"varint,3,opt,name=other_collections_only,json=otherCollectionsOnly,proto3" json:"other_collections_only,omitempty"`
+	// Only return projects of collections in these languages (case-insensitive)
+	Languages     []string `protobuf:"bytes,4,rep,name=languages,proto3" json:"languages,omitempty"`
+	Limit         uint32   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProjectsRequest) Reset() {
-	*x = ListProjectsRequest{}
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[11]
+func (x *FindSimilarProjectsRequest) Reset() {
+	*x = FindSimilarProjectsRequest{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProjectsRequest) String() string {
+func (x *FindSimilarProjectsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectsRequest) ProtoMessage() {}
+func (*FindSimilarProjectsRequest) ProtoMessage() {}
 
-func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[11]
+func (x *FindSimilarProjectsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -671,47 +2647,69 @@ func (x *ListProjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectsRequest.ProtoReflect.Descriptor instead.
-func (*ListProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use FindSimilarProjectsRequest.ProtoReflect.Descriptor instead.
+func (*FindSimilarProjectsRequest) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ListProjectsRequest) GetRepo() *Repository {
+func (x *FindSimilarProjectsRequest) GetProjectId() uint64 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+func (x *FindSimilarProjectsRequest) GetRepo() *Repository {
 	if x != nil {
 		return x.Repo
 	}
 	return nil
 }
 
-func (x *ListProjectsRequest) GetCategoryName() string {
+func (x *FindSimilarProjectsRequest) GetOtherCollectionsOnly() bool {
 	if x != nil {
-		return x.CategoryName
+		return x.OtherCollectionsOnly
 	}
-	return ""
+	return false
 }
 
-type ListProjectsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+func (x *FindSimilarProjectsRequest) GetLanguages() []string {
+	if x != nil {
+		return x.Languages
+	}
+	return nil
+}
+
+func (x *FindSimilarProjectsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type FindSimilarProjectsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Nearest projects first, one per repository
+	Projects      []*SimilarProject `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListProjectsResponse) Reset() {
-	*x = ListProjectsResponse{}
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[12]
+func (x *FindSimilarProjectsResponse) Reset() {
+	*x = FindSimilarProjectsResponse{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListProjectsResponse) String() string {
+func (x *FindSimilarProjectsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListProjectsResponse) ProtoMessage() {}
+func (*FindSimilarProjectsResponse) ProtoMessage() {}
 
-func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[12]
+func (x *FindSimilarProjectsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -722,42 +2720,40 @@ func (x *ListProjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListProjectsResponse.ProtoReflect.Descriptor instead.
-func (*ListProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use FindSimilarProjectsResponse.ProtoReflect.Descriptor instead.
+func (*FindSimilarProjectsResponse) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListProjectsResponse) GetProjects() []*Project {
+func (x *FindSimilarProjectsResponse) GetProjects() []*SimilarProject {
 	if x != nil {
 		return x.Projects
 	}
 	return nil
 }
 
-type SearchProjectsRequest struct {
+type ListDependenciesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	Limit         uint32                 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Repos         []*Repository          `protobuf:"bytes,3,rep,name=repos,proto3" json:"repos,omitempty"`
+	Repo          *Repository            `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchProjectsRequest) Reset() {
-	*x = SearchProjectsRequest{}
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[13]
+func (x *ListDependenciesRequest) Reset() {
+	*x = ListDependenciesRequest{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchProjectsRequest) String() string {
+func (x *ListDependenciesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchProjectsRequest) ProtoMessage() {}
+func (*ListDependenciesRequest) ProtoMessage() {}
 
-func (x *SearchProjectsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[13]
+func (x *ListDependenciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -768,54 +2764,40 @@ func (x *SearchProjectsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchProjectsRequest.ProtoReflect.Descriptor instead.
-func (*SearchProjectsRequest) Descriptor() ([]byte, []int) {
-	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *SearchProjectsRequest) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
-}
-
-func (x *SearchProjectsRequest) GetLimit() uint32 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+// Deprecated: Use ListDependenciesRequest.ProtoReflect.Descriptor instead.
+func (*ListDependenciesRequest) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *SearchProjectsRequest) GetRepos() []*Repository {
+func (x *ListDependenciesRequest) GetRepo() *Repository {
 	if x != nil {
-		return x.Repos
+		return x.Repo
 	}
 	return nil
 }
 
-type SearchProjectsResponse struct {
+type ListDependenciesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	Dependencies  []*DependencyEdge      `protobuf:"bytes,1,rep,name=dependencies,proto3" json:"dependencies,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SearchProjectsResponse) Reset() {
-	*x = SearchProjectsResponse{}
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[14]
+func (x *ListDependenciesResponse) Reset() {
+	*x = ListDependenciesResponse{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SearchProjectsResponse) String() string {
+func (x *ListDependenciesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SearchProjectsResponse) ProtoMessage() {}
+func (*ListDependenciesResponse) ProtoMessage() {}
 
-func (x *SearchProjectsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[14]
+func (x *ListDependenciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -826,40 +2808,40 @@ func (x *SearchProjectsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SearchProjectsResponse.ProtoReflect.Descriptor instead.
-func (*SearchProjectsResponse) Descriptor() ([]byte, []int) {
-	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use ListDependenciesResponse.ProtoReflect.Descriptor instead.
+func (*ListDependenciesResponse) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *SearchProjectsResponse) GetProjects() []*Project {
+func (x *ListDependenciesResponse) GetDependencies() []*DependencyEdge {
 	if x != nil {
-		return x.Projects
+		return x.Dependencies
 	}
 	return nil
 }
 
-type GetProjectStatsRequest struct {
+type ListDependentsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Repo          *Repository            `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProjectStatsRequest) Reset() {
-	*x = GetProjectStatsRequest{}
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[15]
+func (x *ListDependentsRequest) Reset() {
+	*x = ListDependentsRequest{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProjectStatsRequest) String() string {
+func (x *ListDependentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProjectStatsRequest) ProtoMessage() {}
+func (*ListDependentsRequest) ProtoMessage() {}
 
-func (x *GetProjectStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[15]
+func (x *ListDependentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -870,40 +2852,40 @@ func (x *GetProjectStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProjectStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetProjectStatsRequest) Descriptor() ([]byte, []int) {
-	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListDependentsRequest.ProtoReflect.Descriptor instead.
+func (*ListDependentsRequest) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *GetProjectStatsRequest) GetRepo() *Repository {
+func (x *ListDependentsRequest) GetRepo() *Repository {
 	if x != nil {
 		return x.Repo
 	}
 	return nil
 }
 
-type GetProjectStatsResponse struct {
+type ListDependentsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Stats         *ProjectStats          `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	Dependents    []*DependencyEdge      `protobuf:"bytes,1,rep,name=dependents,proto3" json:"dependents,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProjectStatsResponse) Reset() {
-	*x = GetProjectStatsResponse{}
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[16]
+func (x *ListDependentsResponse) Reset() {
+	*x = ListDependentsResponse{}
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProjectStatsResponse) String() string {
+func (x *ListDependentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProjectStatsResponse) ProtoMessage() {}
+func (*ListDependentsResponse) ProtoMessage() {}
 
-func (x *GetProjectStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[16]
+func (x *ListDependentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_myawesomelist_v1_myawesomelist_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -914,14 +2896,14 @@ func (x *GetProjectStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProjectStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetProjectStatsResponse) Descriptor() ([]byte, []int) {
-	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ListDependentsResponse.ProtoReflect.Descriptor instead.
+func (*ListDependentsResponse) Descriptor() ([]byte, []int) {
+	return file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *GetProjectStatsResponse) GetStats() *ProjectStats {
+func (x *ListDependentsResponse) GetDependents() []*DependencyEdge {
 	if x != nil {
-		return x.Stats
+		return x.Dependents
 	}
 	return nil
 }
@@ -930,28 +2912,114 @@ var File_myawesomelist_v1_myawesomelist_proto protoreflect.FileDescriptor
 
 const file_myawesomelist_v1_myawesomelist_proto_rawDesc = "" +
 	"\n" +
-	"$myawesomelist/v1/myawesomelist.proto\x12\x10myawesomelist.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe2\x01\n" +
+	"$myawesomelist/v1/myawesomelist.proto\x12\x10myawesomelist.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcd\t\n" +
 	"\fProjectStats\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12.\n" +
 	"\x10stargazers_count\x18\x02 \x01(\rH\x00R\x0fstargazersCount\x88\x01\x01\x12-\n" +
 	"\x10open_issue_count\x18\x03 \x01(\rH\x01R\x0eopenIssueCount\x88\x01\x01\x129\n" +
 	"\n" +
-	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\x13\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12:\n" +
+	"\x06status\x18\x05 \x01(\x0e2\".myawesomelist.v1.RepositoryStatusR\x06status\x12F\n" +
+	"\x11status_checked_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x0fstatusCheckedAt\x12$\n" +
+	"\vforks_count\x18\a \x01(\rH\x02R\n" +
+	"forksCount\x88\x01\x01\x120\n" +
+	"\x11subscribers_count\x18\b \x01(\rH\x03R\x10subscribersCount\x88\x01\x01\x12\x18\n" +
+	"\alicense\x18\t \x01(\tR\alicense\x12\x16\n" +
+	"\x06topics\x18\n" +
+	" \x03(\tR\x06topics\x12\x1a\n" +
+	"\blanguage\x18\v \x01(\tR\blanguage\x12%\n" +
+	"\x0edefault_branch\x18\f \x01(\tR\rdefaultBranch\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tpushed_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\bpushedAt\x12\x1a\n" +
+	"\barchived\x18\x0f \x01(\bR\barchived\x12@\n" +
+	"\x0elatest_release\x18\x10 \x01(\v2\x19.myawesomelist.v1.ReleaseR\rlatestRelease\x125\n" +
+	"\x14release_cadence_days\x18\x11 \x01(\x01H\x04R\x12releaseCadenceDays\x88\x01\x01\x12&\n" +
+	"\fhealth_score\x18\x12 \x01(\x01H\x05R\vhealthScore\x88\x01\x01\x122\n" +
+	"\x12contributors_count\x18\x13 \x01(\rH\x06R\x11contributorsCount\x88\x01\x01\x127\n" +
+	"\x15top_contributor_share\x18\x14 \x01(\x01H\aR\x13topContributorShare\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"bus_factor\x18\x15 \x01(\rH\bR\tbusFactor\x88\x01\x01\x12F\n" +
+	"\x0eregistry_stats\x18\x16 \x03(\v2\x1f.myawesomelist.v1.RegistryStatsR\rregistryStatsB\x13\n" +
+	"\x11_stargazers_countB\x13\n" +
+	"\x11_open_issue_countB\x0e\n" +
+	"\f_forks_countB\x14\n" +
+	"\x12_subscribers_countB\x17\n" +
+	"\x15_release_cadence_daysB\x0f\n" +
+	"\r_health_scoreB\x15\n" +
+	"\x13_contributors_countB\x18\n" +
+	"\x16_top_contributor_shareB\r\n" +
+	"\v_bus_factor\"\xc2\x02\n" +
+	"\rRegistryStats\x12\x1a\n" +
+	"\bregistry\x18\x01 \x01(\tR\bregistry\x12\x18\n" +
+	"\apackage\x18\x02 \x01(\tR\apackage\x12!\n" +
+	"\tdownloads\x18\x03 \x01(\x04H\x00R\tdownloads\x88\x01\x01\x12)\n" +
+	"\x10downloads_period\x18\x04 \x01(\tR\x0fdownloadsPeriod\x12%\n" +
+	"\x0elatest_version\x18\x05 \x01(\tR\rlatestVersion\x12*\n" +
+	"\x0eversions_count\x18\x06 \x01(\rH\x01R\rversionsCount\x88\x01\x01\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\f\n" +
+	"\n" +
+	"_downloadsB\x11\n" +
+	"\x0f_versions_count\"\xa9\x01\n" +
+	"\aRelease\x12\x19\n" +
+	"\btag_name\x18\x01 \x01(\tR\atagName\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12=\n" +
+	"\fpublished_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vpublishedAt\x12\x1e\n" +
+	"\n" +
+	"prerelease\x18\x04 \x01(\bR\n" +
+	"prerelease\x12\x10\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\"\xd7\x02\n" +
+	"\x11ProjectStatsPoint\x12;\n" +
+	"\vrecorded_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"recordedAt\x12.\n" +
+	"\x10stargazers_count\x18\x02 \x01(\rH\x00R\x0fstargazersCount\x88\x01\x01\x12-\n" +
+	"\x10open_issue_count\x18\x03 \x01(\rH\x01R\x0eopenIssueCount\x88\x01\x01\x12$\n" +
+	"\vforks_count\x18\x04 \x01(\rH\x02R\n" +
+	"forksCount\x88\x01\x01\x120\n" +
+	"\x11subscribers_count\x18\x05 \x01(\rH\x03R\x10subscribersCount\x88\x01\x01B\x13\n" +
 	"\x11_stargazers_countB\x13\n" +
-	"\x11_open_issue_count\"\xbc\x01\n" +
+	"\x11_open_issue_countB\x0e\n" +
+	"\f_forks_countB\x14\n" +
+	"\x12_subscribers_count\"\x99\x04\n" +
 	"\aProject\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x120\n" +
 	"\x04repo\x18\x04 \x01(\v2\x1c.myawesomelist.v1.RepositoryR\x04repo\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xa0\x01\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12:\n" +
+	"\x06status\x18\x06 \x01(\x0e2\".myawesomelist.v1.RepositoryStatusR\x06status\x12@\n" +
+	"\x0elatest_release\x18\a \x01(\v2\x19.myawesomelist.v1.ReleaseR\rlatestRelease\x12&\n" +
+	"\fhealth_score\x18\b \x01(\x01H\x00R\vhealthScore\x88\x01\x01\x12)\n" +
+	"\x10dependents_count\x18\t \x01(\rR\x0fdependentsCount\x129\n" +
+	"\n" +
+	"removed_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tremovedAt\x12>\n" +
+	"\rfirst_seen_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vfirstSeenAtB\x0f\n" +
+	"\r_health_score\"z\n" +
+	"\x0eDependencyEdge\x120\n" +
+	"\x04repo\x18\x01 \x01(\v2\x1c.myawesomelist.v1.RepositoryR\x04repo\x12\x1c\n" +
+	"\tecosystem\x18\x02 \x01(\tR\tecosystem\x12\x18\n" +
+	"\apackage\x18\x03 \x01(\tR\apackage\"\xcc\x01\n" +
+	"\x0fTrendingProject\x123\n" +
+	"\aproject\x18\x01 \x01(\v2\x19.myawesomelist.v1.ProjectR\aproject\x12.\n" +
+	"\x10stargazers_count\x18\x02 \x01(\rH\x00R\x0fstargazersCount\x88\x01\x01\x12)\n" +
+	"\x10stargazers_delta\x18\x03 \x01(\x05R\x0fstargazersDelta\x12\x14\n" +
+	"\x05score\x18\x04 \x01(\x01R\x05scoreB\x13\n" +
+	"\x11_stargazers_count\"}\n" +
+	"\x0eSimilarProject\x123\n" +
+	"\aproject\x18\x01 \x01(\v2\x19.myawesomelist.v1.ProjectR\aproject\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\x01R\bdistance\x12\x1a\n" +
+	"\blanguage\x18\x03 \x01(\tR\blanguage\"\xdb\x01\n" +
 	"\bCategory\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x125\n" +
 	"\bprojects\x18\x03 \x03(\v2\x19.myawesomelist.v1.ProjectR\bprojects\x129\n" +
 	"\n" +
-	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xe1\x01\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"removed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tremovedAt\"\xe1\x01\n" +
 	"\n" +
 	"Collection\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1a\n" +
@@ -961,50 +3029,187 @@ const file_myawesomelist_v1_myawesomelist_proto_rawDesc = "" +
 	"categories\x18\x04 \x03(\v2\x1a.myawesomelist.v1.CategoryR\n" +
 	"categories\x129\n" +
 	"\n" +
-	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"R\n" +
+	"updated_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xf2\x03\n" +
+	"\x0fCollectionEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x129\n" +
+	"\x04type\x18\x02 \x01(\x0e2%.myawesomelist.v1.CollectionEventTypeR\x04type\x12E\n" +
+	"\x0fcollection_repo\x18\x03 \x01(\v2\x1c.myawesomelist.v1.RepositoryR\x0ecollectionRepo\x12?\n" +
+	"\fproject_repo\x18\x04 \x01(\v2\x1c.myawesomelist.v1.RepositoryR\vprojectRepo\x12!\n" +
+	"\fproject_name\x18\x05 \x01(\tR\vprojectName\x12#\n" +
+	"\rcategory_name\x18\x06 \x01(\tR\fcategoryName\x124\n" +
+	"\x16previous_category_name\x18\a \x01(\tR\x14previousCategoryName\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x121\n" +
+	"\x14previous_description\x18\t \x01(\tR\x13previousDescription\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"R\n" +
 	"\n" +
 	"Repository\x12\x1a\n" +
 	"\bhostname\x18\x01 \x01(\tR\bhostname\x12\x14\n" +
 	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x12\n" +
-	"\x04repo\x18\x03 \x01(\tR\x04repo\"L\n" +
+	"\x04repo\x18\x03 \x01(\tR\x04repo\"\x88\x01\n" +
 	"\x16ListCollectionsRequest\x122\n" +
-	"\x05repos\x18\x01 \x03(\v2\x1c.myawesomelist.v1.RepositoryR\x05repos\"Y\n" +
+	"\x05repos\x18\x01 \x03(\v2\x1c.myawesomelist.v1.RepositoryR\x05repos\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xa0\x01\n" +
 	"\x17ListCollectionsResponse\x12>\n" +
-	"\vcollections\x18\x01 \x03(\v2\x1c.myawesomelist.v1.CollectionR\vcollections\"H\n" +
+	"\vcollections\x18\x01 \x03(\v2\x1c.myawesomelist.v1.CollectionR\vcollections\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\rR\ttotalSize\"q\n" +
 	"\x14GetCollectionRequest\x120\n" +
-	"\x04repo\x18\x01 \x01(\v2\x1c.myawesomelist.v1.RepositoryR\x04repo\"U\n" +
+	"\x04repo\x18\x01 \x01(\v2\x1c.myawesomelist.v1.RepositoryR\x04repo\x12'\n" +
+	"\x0finclude_removed\x18\x02 \x01(\bR\x0eincludeRemoved\"U\n" +
 	"\x15GetCollectionResponse\x12<\n" +
 	"\n" +
 	"collection\x18\x01 \x01(\v2\x1c.myawesomelist.v1.CollectionR\n" +
-	"collection\"I\n" +
+	"collection\"\x85\x01\n" +
 	"\x15ListCategoriesRequest\x120\n" +
-	"\x04repo\x18\x01 \x01(\v2\x1c.myawesomelist.v1.RepositoryR\x04repo\"T\n" +
+	"\x04repo\x18\x01 \x01(\v2\x1c.myawesomelist.v1.RepositoryR\x04repo\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x9b\x01\n" +
 	"\x16ListCategoriesResponse\x12:\n" +
 	"\n" +
 	"categories\x18\x01 \x03(\v2\x1a.myawesomelist.v1.CategoryR\n" +
-	"categories\"l\n" +
+	"categories\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\rR\ttotalSize\"\xe5\x01\n" +
 	"\x13ListProjectsRequest\x120\n" +
 	"\x04repo\x18\x01 \x01(\v2\x1c.myawesomelist.v1.RepositoryR\x04repo\x12#\n" +
-	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\"M\n" +
+	"\rcategory_name\x18\x02 \x01(\tR\fcategoryName\x12;\n" +
+	"\border_by\x18\x03 \x01(\x0e2 .myawesomelist.v1.ProjectOrderByR\aorderBy\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x05 \x01(\tR\tpageToken\"\x94\x01\n" +
 	"\x14ListProjectsResponse\x125\n" +
-	"\bprojects\x18\x01 \x03(\v2\x19.myawesomelist.v1.ProjectR\bprojects\"w\n" +
+	"\bprojects\x18\x01 \x03(\v2\x19.myawesomelist.v1.ProjectR\bprojects\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\rR\ttotalSize\"\x8d\x05\n" +
 	"\x15SearchProjectsRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\rR\x05limit\x122\n" +
-	"\x05repos\x18\x03 \x03(\v2\x1c.myawesomelist.v1.RepositoryR\x05repos\"O\n" +
+	"\x05repos\x18\x03 \x03(\v2\x1c.myawesomelist.v1.RepositoryR\x05repos\x12'\n" +
+	"\x0finclude_removed\x18\x04 \x01(\bR\x0eincludeRemoved\x12,\n" +
+	"\x0fsemantic_weight\x18\x05 \x01(\x01H\x00R\x0esemanticWeight\x88\x01\x01\x12*\n" +
+	"\x0elexical_weight\x18\x06 \x01(\x01H\x01R\rlexicalWeight\x88\x01\x01\x12\x1a\n" +
+	"\blanguage\x18\a \x01(\tR\blanguage\x12\x1a\n" +
+	"\bcategory\x18\b \x01(\tR\bcategory\x12 \n" +
+	"\tmin_stars\x18\t \x01(\rH\x02R\bminStars\x88\x01\x01\x12 \n" +
+	"\tmax_stars\x18\n" +
+	" \x01(\rH\x03R\bmaxStars\x88\x01\x01\x12=\n" +
+	"\fpushed_after\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vpushedAfter\x12\x18\n" +
+	"\alicense\x18\f \x01(\tR\alicense\x12\x12\n" +
+	"\x04host\x18\r \x01(\tR\x04host\x12)\n" +
+	"\x10exclude_archived\x18\x0e \x01(\bR\x0fexcludeArchived\x12\x1b\n" +
+	"\tpage_size\x18\x0f \x01(\rR\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x10 \x01(\tR\tpageTokenB\x12\n" +
+	"\x10_semantic_weightB\x11\n" +
+	"\x0f_lexical_weightB\f\n" +
+	"\n" +
+	"_min_starsB\f\n" +
+	"\n" +
+	"_max_stars\"\x96\x01\n" +
 	"\x16SearchProjectsResponse\x125\n" +
-	"\bprojects\x18\x01 \x03(\v2\x19.myawesomelist.v1.ProjectR\bprojects\"J\n" +
+	"\bprojects\x18\x01 \x03(\v2\x19.myawesomelist.v1.ProjectR\bprojects\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\rR\ttotalSize\"J\n" +
 	"\x16GetProjectStatsRequest\x120\n" +
 	"\x04repo\x18\x01 \x01(\v2\x1c.myawesomelist.v1.RepositoryR\x04repo\"O\n" +
 	"\x17GetProjectStatsResponse\x124\n" +
-	"\x05stats\x18\x01 \x01(\v2\x1e.myawesomelist.v1.ProjectStatsR\x05stats2\xeb\x04\n" +
+	"\x05stats\x18\x01 \x01(\v2\x1e.myawesomelist.v1.ProjectStatsR\x05stats\"\x80\x02\n" +
+	"\x1dGetProjectStatsHistoryRequest\x120\n" +
+	"\x04repo\x18\x01 \x01(\v2\x1c.myawesomelist.v1.RepositoryR\x04repo\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12;\n" +
+	"\binterval\x18\x04 \x01(\x0e2\x1f.myawesomelist.v1.StatsIntervalR\binterval\"]\n" +
+	"\x1eGetProjectStatsHistoryResponse\x12;\n" +
+	"\x06points\x18\x01 \x03(\v2#.myawesomelist.v1.ProjectStatsPointR\x06points\"\xe0\x01\n" +
+	"\x1bListTrendingProjectsRequest\x128\n" +
+	"\x06window\x18\x01 \x01(\x0e2 .myawesomelist.v1.TrendingWindowR\x06window\x120\n" +
+	"\x04repo\x18\x02 \x01(\v2\x1c.myawesomelist.v1.RepositoryR\x04repo\x12#\n" +
+	"\rcategory_name\x18\x03 \x01(\tR\fcategoryName\x12\x1a\n" +
+	"\blanguage\x18\x04 \x01(\tR\blanguage\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\rR\x05limit\"]\n" +
+	"\x1cListTrendingProjectsResponse\x12=\n" +
+	"\bprojects\x18\x01 \x03(\v2!.myawesomelist.v1.TrendingProjectR\bprojects\"\xd8\x01\n" +
+	"\x1cListCollectionChangesRequest\x120\n" +
+	"\x04repo\x18\x01 \x01(\v2\x1c.myawesomelist.v1.RepositoryR\x04repo\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\rR\x05limit\"Z\n" +
+	"\x1dListCollectionChangesResponse\x129\n" +
+	"\x06events\x18\x01 \x03(\v2!.myawesomelist.v1.CollectionEventR\x06events\"\xd7\x01\n" +
+	"\x1aFindSimilarProjectsRequest\x12\x1d\n" +
+	"\n" +
+	"project_id\x18\x01 \x01(\x04R\tprojectId\x120\n" +
+	"\x04repo\x18\x02 \x01(\v2\x1c.myawesomelist.v1.RepositoryR\x04repo\x124\n" +
+	"\x16other_collections_only\x18\x03 \x01(\bR\x14otherCollectionsOnly\x12\x1c\n" +
+	"\tlanguages\x18\x04 \x03(\tR\tlanguages\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\rR\x05limit\"[\n" +
+	"\x1bFindSimilarProjectsResponse\x12<\n" +
+	"\bprojects\x18\x01 \x03(\v2 .myawesomelist.v1.SimilarProjectR\bprojects\"K\n" +
+	"\x17ListDependenciesRequest\x120\n" +
+	"\x04repo\x18\x01 \x01(\v2\x1c.myawesomelist.v1.RepositoryR\x04repo\"`\n" +
+	"\x18ListDependenciesResponse\x12D\n" +
+	"\fdependencies\x18\x01 \x03(\v2 .myawesomelist.v1.DependencyEdgeR\fdependencies\"I\n" +
+	"\x15ListDependentsRequest\x120\n" +
+	"\x04repo\x18\x01 \x01(\v2\x1c.myawesomelist.v1.RepositoryR\x04repo\"Z\n" +
+	"\x16ListDependentsResponse\x12@\n" +
+	"\n" +
+	"dependents\x18\x01 \x03(\v2 .myawesomelist.v1.DependencyEdgeR\n" +
+	"dependents*\xd3\x01\n" +
+	"\x10RepositoryStatus\x12!\n" +
+	"\x1dREPOSITORY_STATUS_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18REPOSITORY_STATUS_ACTIVE\x10\x01\x12\x1e\n" +
+	"\x1aREPOSITORY_STATUS_ARCHIVED\x10\x02\x12\x1e\n" +
+	"\x1aREPOSITORY_STATUS_DISABLED\x10\x03\x12\x1f\n" +
+	"\x1bREPOSITORY_STATUS_NOT_FOUND\x10\x04\x12\x1d\n" +
+	"\x19REPOSITORY_STATUS_BLOCKED\x10\x05*\x93\x01\n" +
+	"\rStatsInterval\x12\x1e\n" +
+	"\x1aSTATS_INTERVAL_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13STATS_INTERVAL_HOUR\x10\x01\x12\x16\n" +
+	"\x12STATS_INTERVAL_DAY\x10\x02\x12\x17\n" +
+	"\x13STATS_INTERVAL_WEEK\x10\x03\x12\x18\n" +
+	"\x14STATS_INTERVAL_MONTH\x10\x04*\x7f\n" +
+	"\x0eTrendingWindow\x12\x1f\n" +
+	"\x1bTRENDING_WINDOW_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13TRENDING_WINDOW_DAY\x10\x01\x12\x18\n" +
+	"\x14TRENDING_WINDOW_WEEK\x10\x02\x12\x19\n" +
+	"\x15TRENDING_WINDOW_MONTH\x10\x03*\xef\x01\n" +
+	"\x13CollectionEventType\x12%\n" +
+	"!COLLECTION_EVENT_TYPE_UNSPECIFIED\x10\x00\x12'\n" +
+	"#COLLECTION_EVENT_TYPE_PROJECT_ADDED\x10\x01\x12)\n" +
+	"%COLLECTION_EVENT_TYPE_PROJECT_REMOVED\x10\x02\x12'\n" +
+	"#COLLECTION_EVENT_TYPE_PROJECT_MOVED\x10\x03\x124\n" +
+	"0COLLECTION_EVENT_TYPE_PROJECT_DESCRIPTION_EDITED\x10\x04*\xbc\x01\n" +
+	"\x0eProjectOrderBy\x12 \n" +
+	"\x1cPROJECT_ORDER_BY_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15PROJECT_ORDER_BY_NAME\x10\x01\x12!\n" +
+	"\x1dPROJECT_ORDER_BY_HEALTH_SCORE\x10\x02\x12%\n" +
+	"!PROJECT_ORDER_BY_DEPENDENTS_COUNT\x10\x03\x12#\n" +
+	"\x1fPROJECT_ORDER_BY_RECENTLY_ADDED\x10\x042\x9d\n" +
+	"\n" +
 	"\x0eAwesomeService\x12f\n" +
 	"\x0fListCollections\x12(.myawesomelist.v1.ListCollectionsRequest\x1a).myawesomelist.v1.ListCollectionsResponse\x12`\n" +
-	"\rGetCollection\x12&.myawesomelist.v1.GetCollectionRequest\x1a'.myawesomelist.v1.GetCollectionResponse\x12c\n" +
+	"\rGetCollection\x12&.myawesomelist.v1.GetCollectionRequest\x1a'.myawesomelist.v1.GetCollectionResponse\x12x\n" +
+	"\x15ListCollectionChanges\x12..myawesomelist.v1.ListCollectionChangesRequest\x1a/.myawesomelist.v1.ListCollectionChangesResponse\x12c\n" +
 	"\x0eListCategories\x12'.myawesomelist.v1.ListCategoriesRequest\x1a(.myawesomelist.v1.ListCategoriesResponse\x12]\n" +
 	"\fListProjects\x12%.myawesomelist.v1.ListProjectsRequest\x1a&.myawesomelist.v1.ListProjectsResponse\x12c\n" +
-	"\x0eSearchProjects\x12'.myawesomelist.v1.SearchProjectsRequest\x1a(.myawesomelist.v1.SearchProjectsResponse\x12f\n" +
-	"\x0fGetProjectStats\x12(.myawesomelist.v1.GetProjectStatsRequest\x1a).myawesomelist.v1.GetProjectStatsResponseBLZJmyawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1;myawesomelistv1b\x06proto3"
+	"\x0eSearchProjects\x12'.myawesomelist.v1.SearchProjectsRequest\x1a(.myawesomelist.v1.SearchProjectsResponse\x12r\n" +
+	"\x13FindSimilarProjects\x12,.myawesomelist.v1.FindSimilarProjectsRequest\x1a-.myawesomelist.v1.FindSimilarProjectsResponse\x12f\n" +
+	"\x0fGetProjectStats\x12(.myawesomelist.v1.GetProjectStatsRequest\x1a).myawesomelist.v1.GetProjectStatsResponse\x12{\n" +
+	"\x16GetProjectStatsHistory\x12/.myawesomelist.v1.GetProjectStatsHistoryRequest\x1a0.myawesomelist.v1.GetProjectStatsHistoryResponse\x12u\n" +
+	"\x14ListTrendingProjects\x12-.myawesomelist.v1.ListTrendingProjectsRequest\x1a..myawesomelist.v1.ListTrendingProjectsResponse\x12i\n" +
+	"\x10ListDependencies\x12).myawesomelist.v1.ListDependenciesRequest\x1a*.myawesomelist.v1.ListDependenciesResponse\x12c\n" +
+	"\x0eListDependents\x12'.myawesomelist.v1.ListDependentsRequest\x1a(.myawesomelist.v1.ListDependentsResponseBLZJmyawesomelist.shikanime.studio/pkgs/proto/myawesomelist/v1;myawesomelistv1b\x06proto3"
 
 var (
 	file_myawesomelist_v1_myawesomelist_proto_rawDescOnce sync.Once
@@ -1018,65 +3223,143 @@ func file_myawesomelist_v1_myawesomelist_proto_rawDescGZIP() []byte {
 	return file_myawesomelist_v1_myawesomelist_proto_rawDescData
 }
 
-var file_myawesomelist_v1_myawesomelist_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_myawesomelist_v1_myawesomelist_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_myawesomelist_v1_myawesomelist_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_myawesomelist_v1_myawesomelist_proto_goTypes = []any{
-	(*ProjectStats)(nil),            // 0: myawesomelist.v1.ProjectStats
-	(*Project)(nil),                 // 1: myawesomelist.v1.Project
-	(*Category)(nil),                // 2: myawesomelist.v1.Category
-	(*Collection)(nil),              // 3: myawesomelist.v1.Collection
-	(*Repository)(nil),              // 4: myawesomelist.v1.Repository
-	(*ListCollectionsRequest)(nil),  // 5: myawesomelist.v1.ListCollectionsRequest
-	(*ListCollectionsResponse)(nil), // 6: myawesomelist.v1.ListCollectionsResponse
-	(*GetCollectionRequest)(nil),    // 7: myawesomelist.v1.GetCollectionRequest
-	(*GetCollectionResponse)(nil),   // 8: myawesomelist.v1.GetCollectionResponse
-	(*ListCategoriesRequest)(nil),   // 9: myawesomelist.v1.ListCategoriesRequest
-	(*ListCategoriesResponse)(nil),  // 10: myawesomelist.v1.ListCategoriesResponse
-	(*ListProjectsRequest)(nil),     // 11: myawesomelist.v1.ListProjectsRequest
-	(*ListProjectsResponse)(nil),    // 12: myawesomelist.v1.ListProjectsResponse
-	(*SearchProjectsRequest)(nil),   // 13: myawesomelist.v1.SearchProjectsRequest
-	(*SearchProjectsResponse)(nil),  // 14: myawesomelist.v1.SearchProjectsResponse
-	(*GetProjectStatsRequest)(nil),  // 15: myawesomelist.v1.GetProjectStatsRequest
-	(*GetProjectStatsResponse)(nil), // 16: myawesomelist.v1.GetProjectStatsResponse
-	(*timestamppb.Timestamp)(nil),   // 17: google.protobuf.Timestamp
+	(RepositoryStatus)(0),                  // 0: myawesomelist.v1.RepositoryStatus
+	(StatsInterval)(0),                     // 1: myawesomelist.v1.StatsInterval
+	(TrendingWindow)(0),                    // 2: myawesomelist.v1.TrendingWindow
+	(CollectionEventType)(0),               // 3: myawesomelist.v1.CollectionEventType
+	(ProjectOrderBy)(0),                    // 4: myawesomelist.v1.ProjectOrderBy
+	(*ProjectStats)(nil),                   // 5: myawesomelist.v1.ProjectStats
+	(*RegistryStats)(nil),                  // 6: myawesomelist.v1.RegistryStats
+	(*Release)(nil),                        // 7: myawesomelist.v1.Release
+	(*ProjectStatsPoint)(nil),              // 8: myawesomelist.v1.ProjectStatsPoint
+	(*Project)(nil),                        // 9: myawesomelist.v1.Project
+	(*DependencyEdge)(nil),                 // 10: myawesomelist.v1.DependencyEdge
+	(*TrendingProject)(nil),                // 11: myawesomelist.v1.TrendingProject
+	(*SimilarProject)(nil),                 // 12: myawesomelist.v1.SimilarProject
+	(*Category)(nil),                       // 13: myawesomelist.v1.Category
+	(*Collection)(nil),                     // 14: myawesomelist.v1.Collection
+	(*CollectionEvent)(nil),                // 15: myawesomelist.v1.CollectionEvent
+	(*Repository)(nil),                     // 16: myawesomelist.v1.Repository
+	(*ListCollectionsRequest)(nil),         // 17: myawesomelist.v1.ListCollectionsRequest
+	(*ListCollectionsResponse)(nil),        // 18: myawesomelist.v1.ListCollectionsResponse
+	(*GetCollectionRequest)(nil),           // 19: myawesomelist.v1.GetCollectionRequest
+	(*GetCollectionResponse)(nil),          // 20: myawesomelist.v1.GetCollectionResponse
+	(*ListCategoriesRequest)(nil),          // 21: myawesomelist.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),         // 22: myawesomelist.v1.ListCategoriesResponse
+	(*ListProjectsRequest)(nil),            // 23: myawesomelist.v1.ListProjectsRequest
+	(*ListProjectsResponse)(nil),           // 24: myawesomelist.v1.ListProjectsResponse
+	(*SearchProjectsRequest)(nil),          // 25: myawesomelist.v1.SearchProjectsRequest
+	(*SearchProjectsResponse)(nil),         // 26: myawesomelist.v1.SearchProjectsResponse
+	(*GetProjectStatsRequest)(nil),         // 27: myawesomelist.v1.GetProjectStatsRequest
+	(*GetProjectStatsResponse)(nil),        // 28: myawesomelist.v1.GetProjectStatsResponse
+	(*GetProjectStatsHistoryRequest)(nil),  // 29: myawesomelist.v1.GetProjectStatsHistoryRequest
+	(*GetProjectStatsHistoryResponse)(nil), // 30: myawesomelist.v1.GetProjectStatsHistoryResponse
+	(*ListTrendingProjectsRequest)(nil),    // 31: myawesomelist.v1.ListTrendingProjectsRequest
+	(*ListTrendingProjectsResponse)(nil),   // 32: myawesomelist.v1.ListTrendingProjectsResponse
+	(*ListCollectionChangesRequest)(nil),   // 33: myawesomelist.v1.ListCollectionChangesRequest
+	(*ListCollectionChangesResponse)(nil),  // 34: myawesomelist.v1.ListCollectionChangesResponse
+	(*FindSimilarProjectsRequest)(nil),     // 35: myawesomelist.v1.FindSimilarProjectsRequest
+	(*FindSimilarProjectsResponse)(nil),    // 36: myawesomelist.v1.FindSimilarProjectsResponse
+	(*ListDependenciesRequest)(nil),        // 37: myawesomelist.v1.ListDependenciesRequest
+	(*ListDependenciesResponse)(nil),       // 38: myawesomelist.v1.ListDependenciesResponse
+	(*ListDependentsRequest)(nil),          // 39: myawesomelist.v1.ListDependentsRequest
+	(*ListDependentsResponse)(nil),         // 40: myawesomelist.v1.ListDependentsResponse
+	(*timestamppb.Timestamp)(nil),          // 41: google.protobuf.Timestamp
 }
 var file_myawesomelist_v1_myawesomelist_proto_depIdxs = []int32{
-	17, // 0: myawesomelist.v1.ProjectStats.updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 1: myawesomelist.v1.Project.repo:type_name -> myawesomelist.v1.Repository
-	17, // 2: myawesomelist.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
-	1,  // 3: myawesomelist.v1.Category.projects:type_name -> myawesomelist.v1.Project
-	17, // 4: myawesomelist.v1.Category.updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 5: myawesomelist.v1.Collection.repo:type_name -> myawesomelist.v1.Repository
-	2,  // 6: myawesomelist.v1.Collection.categories:type_name -> myawesomelist.v1.Category
-	17, // 7: myawesomelist.v1.Collection.updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 8: myawesomelist.v1.ListCollectionsRequest.repos:type_name -> myawesomelist.v1.Repository
-	3,  // 9: myawesomelist.v1.ListCollectionsResponse.collections:type_name -> myawesomelist.v1.Collection
-	4,  // 10: myawesomelist.v1.GetCollectionRequest.repo:type_name -> myawesomelist.v1.Repository
-	3,  // 11: myawesomelist.v1.GetCollectionResponse.collection:type_name -> myawesomelist.v1.Collection
-	4,  // 12: myawesomelist.v1.ListCategoriesRequest.repo:type_name -> myawesomelist.v1.Repository
-	2,  // 13: myawesomelist.v1.ListCategoriesResponse.categories:type_name -> myawesomelist.v1.Category
-	4,  // 14: myawesomelist.v1.ListProjectsRequest.repo:type_name -> myawesomelist.v1.Repository
-	1,  // 15: myawesomelist.v1.ListProjectsResponse.projects:type_name -> myawesomelist.v1.Project
-	4,  // 16: myawesomelist.v1.SearchProjectsRequest.repos:type_name -> myawesomelist.v1.Repository
-	1,  // 17: myawesomelist.v1.SearchProjectsResponse.projects:type_name -> myawesomelist.v1.Project
-	4,  // 18: myawesomelist.v1.GetProjectStatsRequest.repo:type_name -> myawesomelist.v1.Repository
-	0,  // 19: myawesomelist.v1.GetProjectStatsResponse.stats:type_name -> myawesomelist.v1.ProjectStats
-	5,  // 20: myawesomelist.v1.AwesomeService.ListCollections:input_type -> myawesomelist.v1.ListCollectionsRequest
-	7,  // 21: myawesomelist.v1.AwesomeService.GetCollection:input_type -> myawesomelist.v1.GetCollectionRequest
-	9,  // 22: myawesomelist.v1.AwesomeService.ListCategories:input_type -> myawesomelist.v1.ListCategoriesRequest
-	11, // 23: myawesomelist.v1.AwesomeService.ListProjects:input_type -> myawesomelist.v1.ListProjectsRequest
-	13, // 24: myawesomelist.v1.AwesomeService.SearchProjects:input_type -> myawesomelist.v1.SearchProjectsRequest
-	15, // 25: myawesomelist.v1.AwesomeService.GetProjectStats:input_type -> myawesomelist.v1.GetProjectStatsRequest
-	6,  // 26: myawesomelist.v1.AwesomeService.ListCollections:output_type -> myawesomelist.v1.ListCollectionsResponse
-	8,  // 27: myawesomelist.v1.AwesomeService.GetCollection:output_type -> myawesomelist.v1.GetCollectionResponse
-	10, // 28: myawesomelist.v1.AwesomeService.ListCategories:output_type -> myawesomelist.v1.ListCategoriesResponse
-	12, // 29: myawesomelist.v1.AwesomeService.ListProjects:output_type -> myawesomelist.v1.ListProjectsResponse
-	14, // 30: myawesomelist.v1.AwesomeService.SearchProjects:output_type -> myawesomelist.v1.SearchProjectsResponse
-	16, // 31: myawesomelist.v1.AwesomeService.GetProjectStats:output_type -> myawesomelist.v1.GetProjectStatsResponse
-	26, // [26:32] is the sub-list for method output_type
-	20, // [20:26] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	41, // 0: myawesomelist.v1.ProjectStats.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 1: myawesomelist.v1.ProjectStats.status:type_name -> myawesomelist.v1.RepositoryStatus
+	41, // 2: myawesomelist.v1.ProjectStats.status_checked_at:type_name -> google.protobuf.Timestamp
+	41, // 3: myawesomelist.v1.ProjectStats.created_at:type_name -> google.protobuf.Timestamp
+	41, // 4: myawesomelist.v1.ProjectStats.pushed_at:type_name -> google.protobuf.Timestamp
+	7,  // 5: myawesomelist.v1.ProjectStats.latest_release:type_name -> myawesomelist.v1.Release
+	6,  // 6: myawesomelist.v1.ProjectStats.registry_stats:type_name -> myawesomelist.v1.RegistryStats
+	41, // 7: myawesomelist.v1.RegistryStats.updated_at:type_name -> google.protobuf.Timestamp
+	41, // 8: myawesomelist.v1.Release.published_at:type_name -> google.protobuf.Timestamp
+	41, // 9: myawesomelist.v1.ProjectStatsPoint.recorded_at:type_name -> google.protobuf.Timestamp
+	16, // 10: myawesomelist.v1.Project.repo:type_name -> myawesomelist.v1.Repository
+	41, // 11: myawesomelist.v1.Project.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 12: myawesomelist.v1.Project.status:type_name -> myawesomelist.v1.RepositoryStatus
+	7,  // 13: myawesomelist.v1.Project.latest_release:type_name -> myawesomelist.v1.Release
+	41, // 14: myawesomelist.v1.Project.removed_at:type_name -> google.protobuf.Timestamp
+	41, // 15: myawesomelist.v1.Project.first_seen_at:type_name -> google.protobuf.Timestamp
+	16, // 16: myawesomelist.v1.DependencyEdge.repo:type_name -> myawesomelist.v1.Repository
+	9,  // 17: myawesomelist.v1.TrendingProject.project:type_name -> myawesomelist.v1.Project
+	9,  // 18: myawesomelist.v1.SimilarProject.project:type_name -> myawesomelist.v1.Project
+	9,  // 19: myawesomelist.v1.Category.projects:type_name -> myawesomelist.v1.Project
+	41, // 20: myawesomelist.v1.Category.updated_at:type_name -> google.protobuf.Timestamp
+	41, // 21: myawesomelist.v1.Category.removed_at:type_name -> google.protobuf.Timestamp
+	16, // 22: myawesomelist.v1.Collection.repo:type_name -> myawesomelist.v1.Repository
+	13, // 23: myawesomelist.v1.Collection.categories:type_name -> myawesomelist.v1.Category
+	41, // 24: myawesomelist.v1.Collection.updated_at:type_name -> google.protobuf.Timestamp
+	3,  // 25: myawesomelist.v1.CollectionEvent.type:type_name -> myawesomelist.v1.CollectionEventType
+	16, // 26: myawesomelist.v1.CollectionEvent.collection_repo:type_name -> myawesomelist.v1.Repository
+	16, // 27: myawesomelist.v1.CollectionEvent.project_repo:type_name -> myawesomelist.v1.Repository
+	41, // 28: myawesomelist.v1.CollectionEvent.created_at:type_name -> google.protobuf.Timestamp
+	16, // 29: myawesomelist.v1.ListCollectionsRequest.repos:type_name -> myawesomelist.v1.Repository
+	14, // 30: myawesomelist.v1.ListCollectionsResponse.collections:type_name -> myawesomelist.v1.Collection
+	16, // 31: myawesomelist.v1.GetCollectionRequest.repo:type_name -> myawesomelist.v1.Repository
+	14, // 32: myawesomelist.v1.GetCollectionResponse.collection:type_name -> myawesomelist.v1.Collection
+	16, // 33: myawesomelist.v1.ListCategoriesRequest.repo:type_name -> myawesomelist.v1.Repository
+	13, // 34: myawesomelist.v1.ListCategoriesResponse.categories:type_name -> myawesomelist.v1.Category
+	16, // 35: myawesomelist.v1.ListProjectsRequest.repo:type_name -> myawesomelist.v1.Repository
+	4,  // 36: myawesomelist.v1.ListProjectsRequest.order_by:type_name -> myawesomelist.v1.ProjectOrderBy
+	9,  // 37: myawesomelist.v1.ListProjectsResponse.projects:type_name -> myawesomelist.v1.Project
+	16, // 38: myawesomelist.v1.SearchProjectsRequest.repos:type_name -> myawesomelist.v1.Repository
+	41, // 39: myawesomelist.v1.SearchProjectsRequest.pushed_after:type_name -> google.protobuf.Timestamp
+	9,  // 40: myawesomelist.v1.SearchProjectsResponse.projects:type_name -> myawesomelist.v1.Project
+	16, // 41: myawesomelist.v1.GetProjectStatsRequest.repo:type_name -> myawesomelist.v1.Repository
+	5,  // 42: myawesomelist.v1.GetProjectStatsResponse.stats:type_name -> myawesomelist.v1.ProjectStats
+	16, // 43: myawesomelist.v1.GetProjectStatsHistoryRequest.repo:type_name -> myawesomelist.v1.Repository
+	41, // 44: myawesomelist.v1.GetProjectStatsHistoryRequest.start_time:type_name -> google.protobuf.Timestamp
+	41, // 45: myawesomelist.v1.GetProjectStatsHistoryRequest.end_time:type_name -> google.protobuf.Timestamp
+	1,  // 46: myawesomelist.v1.GetProjectStatsHistoryRequest.interval:type_name -> myawesomelist.v1.StatsInterval
+	8,  // 47: myawesomelist.v1.GetProjectStatsHistoryResponse.points:type_name -> myawesomelist.v1.ProjectStatsPoint
+	2,  // 48: myawesomelist.v1.ListTrendingProjectsRequest.window:type_name -> myawesomelist.v1.TrendingWindow
+	16, // 49: myawesomelist.v1.ListTrendingProjectsRequest.repo:type_name -> myawesomelist.v1.Repository
+	11, // 50: myawesomelist.v1.ListTrendingProjectsResponse.projects:type_name -> myawesomelist.v1.TrendingProject
+	16, // 51: myawesomelist.v1.ListCollectionChangesRequest.repo:type_name -> myawesomelist.v1.Repository
+	41, // 52: myawesomelist.v1.ListCollectionChangesRequest.start_time:type_name -> google.protobuf.Timestamp
+	41, // 53: myawesomelist.v1.ListCollectionChangesRequest.end_time:type_name -> google.protobuf.Timestamp
+	15, // 54: myawesomelist.v1.ListCollectionChangesResponse.events:type_name -> myawesomelist.v1.CollectionEvent
+	16, // 55: myawesomelist.v1.FindSimilarProjectsRequest.repo:type_name -> myawesomelist.v1.Repository
+	12, // 56: myawesomelist.v1.FindSimilarProjectsResponse.projects:type_name -> myawesomelist.v1.SimilarProject
+	16, // 57: myawesomelist.v1.ListDependenciesRequest.repo:type_name -> myawesomelist.v1.Repository
+	10, // 58: myawesomelist.v1.ListDependenciesResponse.dependencies:type_name -> myawesomelist.v1.DependencyEdge
+	16, // 59: myawesomelist.v1.ListDependentsRequest.repo:type_name -> myawesomelist.v1.Repository
+	10, // 60: myawesomelist.v1.ListDependentsResponse.dependents:type_name -> myawesomelist.v1.DependencyEdge
+	17, // 61: myawesomelist.v1.AwesomeService.ListCollections:input_type -> myawesomelist.v1.ListCollectionsRequest
+	19, // 62: myawesomelist.v1.AwesomeService.GetCollection:input_type -> myawesomelist.v1.GetCollectionRequest
+	33, // 63: myawesomelist.v1.AwesomeService.ListCollectionChanges:input_type -> myawesomelist.v1.ListCollectionChangesRequest
+	21, // 64: myawesomelist.v1.AwesomeService.ListCategories:input_type -> myawesomelist.v1.ListCategoriesRequest
+	23, // 65: myawesomelist.v1.AwesomeService.ListProjects:input_type -> myawesomelist.v1.ListProjectsRequest
+	25, // 66: myawesomelist.v1.AwesomeService.SearchProjects:input_type -> myawesomelist.v1.SearchProjectsRequest
+	35, // 67: myawesomelist.v1.AwesomeService.FindSimilarProjects:input_type -> myawesomelist.v1.FindSimilarProjectsRequest
+	27, // 68: myawesomelist.v1.AwesomeService.GetProjectStats:input_type -> myawesomelist.v1.GetProjectStatsRequest
+	29, // 69: myawesomelist.v1.AwesomeService.GetProjectStatsHistory:input_type -> myawesomelist.v1.GetProjectStatsHistoryRequest
+	31, // 70: myawesomelist.v1.AwesomeService.ListTrendingProjects:input_type -> myawesomelist.v1.ListTrendingProjectsRequest
+	37, // 71: myawesomelist.v1.AwesomeService.ListDependencies:input_type -> myawesomelist.v1.ListDependenciesRequest
+	39, // 72: myawesomelist.v1.AwesomeService.ListDependents:input_type -> myawesomelist.v1.ListDependentsRequest
+	18, // 73: myawesomelist.v1.AwesomeService.ListCollections:output_type -> myawesomelist.v1.ListCollectionsResponse
+	20, // 74: myawesomelist.v1.AwesomeService.GetCollection:output_type -> myawesomelist.v1.GetCollectionResponse
+	34, // 75: myawesomelist.v1.AwesomeService.ListCollectionChanges:output_type -> myawesomelist.v1.ListCollectionChangesResponse
+	22, // 76: myawesomelist.v1.AwesomeService.ListCategories:output_type -> myawesomelist.v1.ListCategoriesResponse
+	24, // 77: myawesomelist.v1.AwesomeService.ListProjects:output_type -> myawesomelist.v1.ListProjectsResponse
+	26, // 78: myawesomelist.v1.AwesomeService.SearchProjects:output_type -> myawesomelist.v1.SearchProjectsResponse
+	36, // 79: myawesomelist.v1.AwesomeService.FindSimilarProjects:output_type -> myawesomelist.v1.FindSimilarProjectsResponse
+	28, // 80: myawesomelist.v1.AwesomeService.GetProjectStats:output_type -> myawesomelist.v1.GetProjectStatsResponse
+	30, // 81: myawesomelist.v1.AwesomeService.GetProjectStatsHistory:output_type -> myawesomelist.v1.GetProjectStatsHistoryResponse
+	32, // 82: myawesomelist.v1.AwesomeService.ListTrendingProjects:output_type -> myawesomelist.v1.ListTrendingProjectsResponse
+	38, // 83: myawesomelist.v1.AwesomeService.ListDependencies:output_type -> myawesomelist.v1.ListDependenciesResponse
+	40, // 84: myawesomelist.v1.AwesomeService.ListDependents:output_type -> myawesomelist.v1.ListDependentsResponse
+	73, // [73:85] is the sub-list for method output_type
+	61, // [61:73] is the sub-list for method input_type
+	61, // [61:61] is the sub-list for extension type_name
+	61, // [61:61] is the sub-list for extension extendee
+	0,  // [0:61] is the sub-list for field type_name
 }
 
 func init() { file_myawesomelist_v1_myawesomelist_proto_init() }
@@ -1085,18 +3368,24 @@ func file_myawesomelist_v1_myawesomelist_proto_init() {
 		return
 	}
 	file_myawesomelist_v1_myawesomelist_proto_msgTypes[0].OneofWrappers = []any{}
+	file_myawesomelist_v1_myawesomelist_proto_msgTypes[1].OneofWrappers = []any{}
+	file_myawesomelist_v1_myawesomelist_proto_msgTypes[3].OneofWrappers = []any{}
+	file_myawesomelist_v1_myawesomelist_proto_msgTypes[4].OneofWrappers = []any{}
+	file_myawesomelist_v1_myawesomelist_proto_msgTypes[6].OneofWrappers = []any{}
+	file_myawesomelist_v1_myawesomelist_proto_msgTypes[20].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_myawesomelist_v1_myawesomelist_proto_rawDesc), len(file_myawesomelist_v1_myawesomelist_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   17,
+			NumEnums:      5,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_myawesomelist_v1_myawesomelist_proto_goTypes,
 		DependencyIndexes: file_myawesomelist_v1_myawesomelist_proto_depIdxs,
+		EnumInfos:         file_myawesomelist_v1_myawesomelist_proto_enumTypes,
 		MessageInfos:      file_myawesomelist_v1_myawesomelist_proto_msgTypes,
 	}.Build()
 	File_myawesomelist_v1_myawesomelist_proto = out.File
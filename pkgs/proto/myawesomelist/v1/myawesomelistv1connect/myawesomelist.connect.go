@@ -39,6 +39,9 @@ const (
 	// AwesomeServiceGetCollectionProcedure is the fully-qualified name of the AwesomeService's
 	// GetCollection RPC.
 	AwesomeServiceGetCollectionProcedure = "/myawesomelist.v1.AwesomeService/GetCollection"
+	// AwesomeServiceListCollectionChangesProcedure is the fully-qualified name of the AwesomeService's
+	// ListCollectionChanges RPC.
+	AwesomeServiceListCollectionChangesProcedure = "/myawesomelist.v1.AwesomeService/ListCollectionChanges"
 	// AwesomeServiceListCategoriesProcedure is the fully-qualified name of the AwesomeService's
 	// ListCategories RPC.
 	AwesomeServiceListCategoriesProcedure = "/myawesomelist.v1.AwesomeService/ListCategories"
@@ -48,19 +51,40 @@ const (
 	// AwesomeServiceSearchProjectsProcedure is the fully-qualified name of the AwesomeService's
 	// SearchProjects RPC.
 	AwesomeServiceSearchProjectsProcedure = "/myawesomelist.v1.AwesomeService/SearchProjects"
+	// AwesomeServiceFindSimilarProjectsProcedure is the fully-qualified name of the AwesomeService's
+	// FindSimilarProjects RPC.
+	AwesomeServiceFindSimilarProjectsProcedure = "/myawesomelist.v1.AwesomeService/FindSimilarProjects"
 	// AwesomeServiceGetProjectStatsProcedure is the fully-qualified name of the AwesomeService's
 	// GetProjectStats RPC.
 	AwesomeServiceGetProjectStatsProcedure = "/myawesomelist.v1.AwesomeService/GetProjectStats"
+	// AwesomeServiceGetProjectStatsHistoryProcedure is the fully-qualified name of the AwesomeService's
+	// GetProjectStatsHistory RPC.
+	AwesomeServiceGetProjectStatsHistoryProcedure = "/myawesomelist.v1.AwesomeService/GetProjectStatsHistory"
+	// AwesomeServiceListTrendingProjectsProcedure is the fully-qualified name of the AwesomeService's
+	// ListTrendingProjects RPC.
+	AwesomeServiceListTrendingProjectsProcedure = "/myawesomelist.v1.AwesomeService/ListTrendingProjects"
+	// AwesomeServiceListDependenciesProcedure is the fully-qualified name of the AwesomeService's
+	// ListDependencies RPC.
+	AwesomeServiceListDependenciesProcedure = "/myawesomelist.v1.AwesomeService/ListDependencies"
+	// AwesomeServiceListDependentsProcedure is the fully-qualified name of the AwesomeService's
+	// ListDependents RPC.
+	AwesomeServiceListDependentsProcedure = "/myawesomelist.v1.AwesomeService/ListDependents"
 )
 
 // AwesomeServiceClient is a client for the myawesomelist.v1.AwesomeService service.
 type AwesomeServiceClient interface {
 	ListCollections(context.Context, *connect.Request[v1.ListCollectionsRequest]) (*connect.Response[v1.ListCollectionsResponse], error)
 	GetCollection(context.Context, *connect.Request[v1.GetCollectionRequest]) (*connect.Response[v1.GetCollectionResponse], error)
+	ListCollectionChanges(context.Context, *connect.Request[v1.ListCollectionChangesRequest]) (*connect.Response[v1.ListCollectionChangesResponse], error)
 	ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error)
 	ListProjects(context.Context, *connect.Request[v1.ListProjectsRequest]) (*connect.Response[v1.ListProjectsResponse], error)
 	SearchProjects(context.Context, *connect.Request[v1.SearchProjectsRequest]) (*connect.Response[v1.SearchProjectsResponse], error)
+	FindSimilarProjects(context.Context, *connect.Request[v1.FindSimilarProjectsRequest]) (*connect.Response[v1.FindSimilarProjectsResponse], error)
 	GetProjectStats(context.Context, *connect.Request[v1.GetProjectStatsRequest]) (*connect.Response[v1.GetProjectStatsResponse], error)
+	GetProjectStatsHistory(context.Context, *connect.Request[v1.GetProjectStatsHistoryRequest]) (*connect.Response[v1.GetProjectStatsHistoryResponse], error)
+	ListTrendingProjects(context.Context, *connect.Request[v1.ListTrendingProjectsRequest]) (*connect.Response[v1.ListTrendingProjectsResponse], error)
+	ListDependencies(context.Context, *connect.Request[v1.ListDependenciesRequest]) (*connect.Response[v1.ListDependenciesResponse], error)
+	ListDependents(context.Context, *connect.Request[v1.ListDependentsRequest]) (*connect.Response[v1.ListDependentsResponse], error)
 }
 
 // NewAwesomeServiceClient constructs a client for the myawesomelist.v1.AwesomeService service. By
@@ -86,6 +110,12 @@ func NewAwesomeServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(awesomeServiceMethods.ByName("GetCollection")),
 			connect.WithClientOptions(opts...),
 		),
+		listCollectionChanges: connect.NewClient[v1.ListCollectionChangesRequest, v1.ListCollectionChangesResponse](
+			httpClient,
+			baseURL+AwesomeServiceListCollectionChangesProcedure,
+			connect.WithSchema(awesomeServiceMethods.ByName("ListCollectionChanges")),
+			connect.WithClientOptions(opts...),
+		),
 		listCategories: connect.NewClient[v1.ListCategoriesRequest, v1.ListCategoriesResponse](
 			httpClient,
 			baseURL+AwesomeServiceListCategoriesProcedure,
@@ -104,23 +134,59 @@ func NewAwesomeServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(awesomeServiceMethods.ByName("SearchProjects")),
 			connect.WithClientOptions(opts...),
 		),
+		findSimilarProjects: connect.NewClient[v1.FindSimilarProjectsRequest, v1.FindSimilarProjectsResponse](
+			httpClient,
+			baseURL+AwesomeServiceFindSimilarProjectsProcedure,
+			connect.WithSchema(awesomeServiceMethods.ByName("FindSimilarProjects")),
+			connect.WithClientOptions(opts...),
+		),
 		getProjectStats: connect.NewClient[v1.GetProjectStatsRequest, v1.GetProjectStatsResponse](
 			httpClient,
 			baseURL+AwesomeServiceGetProjectStatsProcedure,
 			connect.WithSchema(awesomeServiceMethods.ByName("GetProjectStats")),
 			connect.WithClientOptions(opts...),
 		),
+		getProjectStatsHistory: connect.NewClient[v1.GetProjectStatsHistoryRequest, v1.GetProjectStatsHistoryResponse](
+			httpClient,
+			baseURL+AwesomeServiceGetProjectStatsHistoryProcedure,
+			connect.WithSchema(awesomeServiceMethods.ByName("GetProjectStatsHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		listTrendingProjects: connect.NewClient[v1.ListTrendingProjectsRequest, v1.ListTrendingProjectsResponse](
+			httpClient,
+			baseURL+AwesomeServiceListTrendingProjectsProcedure,
+			connect.WithSchema(awesomeServiceMethods.ByName("ListTrendingProjects")),
+			connect.WithClientOptions(opts...),
+		),
+		listDependencies: connect.NewClient[v1.ListDependenciesRequest, v1.ListDependenciesResponse](
+			httpClient,
+			baseURL+AwesomeServiceListDependenciesProcedure,
+			connect.WithSchema(awesomeServiceMethods.ByName("ListDependencies")),
+			connect.WithClientOptions(opts...),
+		),
+		listDependents: connect.NewClient[v1.ListDependentsRequest, v1.ListDependentsResponse](
+			httpClient,
+			baseURL+AwesomeServiceListDependentsProcedure,
+			connect.WithSchema(awesomeServiceMethods.ByName("ListDependents")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // awesomeServiceClient implements AwesomeServiceClient.
 type awesomeServiceClient struct {
-	listCollections *connect.Client[v1.ListCollectionsRequest, v1.ListCollectionsResponse]
-	getCollection   *connect.Client[v1.GetCollectionRequest, v1.GetCollectionResponse]
-	listCategories  *connect.Client[v1.ListCategoriesRequest, v1.ListCategoriesResponse]
-	listProjects    *connect.Client[v1.ListProjectsRequest, v1.ListProjectsResponse]
-	searchProjects  *connect.Client[v1.SearchProjectsRequest, v1.SearchProjectsResponse]
-	getProjectStats *connect.Client[v1.GetProjectStatsRequest, v1.GetProjectStatsResponse]
+	listCollections        *connect.Client[v1.ListCollectionsRequest, v1.ListCollectionsResponse]
+	getCollection          *connect.Client[v1.GetCollectionRequest, v1.GetCollectionResponse]
+	listCollectionChanges  *connect.Client[v1.ListCollectionChangesRequest, v1.ListCollectionChangesResponse]
+	listCategories         *connect.Client[v1.ListCategoriesRequest, v1.ListCategoriesResponse]
+	listProjects           *connect.Client[v1.ListProjectsRequest, v1.ListProjectsResponse]
+	searchProjects         *connect.Client[v1.SearchProjectsRequest, v1.SearchProjectsResponse]
+	findSimilarProjects    *connect.Client[v1.FindSimilarProjectsRequest, v1.FindSimilarProjectsResponse]
+	getProjectStats        *connect.Client[v1.GetProjectStatsRequest, v1.GetProjectStatsResponse]
+	getProjectStatsHistory *connect.Client[v1.GetProjectStatsHistoryRequest, v1.GetProjectStatsHistoryResponse]
+	listTrendingProjects   *connect.Client[v1.ListTrendingProjectsRequest, v1.ListTrendingProjectsResponse]
+	listDependencies       *connect.Client[v1.ListDependenciesRequest, v1.ListDependenciesResponse]
+	listDependents         *connect.Client[v1.ListDependentsRequest, v1.ListDependentsResponse]
 }
 
 // ListCollections calls myawesomelist.v1.AwesomeService.ListCollections.
@@ -133,6 +199,11 @@ func (c *awesomeServiceClient) GetCollection(ctx context.Context, req *connect.R
 	return c.getCollection.CallUnary(ctx, req)
 }
 
+// ListCollectionChanges calls myawesomelist.v1.AwesomeService.ListCollectionChanges.
+func (c *awesomeServiceClient) ListCollectionChanges(ctx context.Context, req *connect.Request[v1.ListCollectionChangesRequest]) (*connect.Response[v1.ListCollectionChangesResponse], error) {
+	return c.listCollectionChanges.CallUnary(ctx, req)
+}
+
 // ListCategories calls myawesomelist.v1.AwesomeService.ListCategories.
 func (c *awesomeServiceClient) ListCategories(ctx context.Context, req *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error) {
 	return c.listCategories.CallUnary(ctx, req)
@@ -148,19 +219,50 @@ func (c *awesomeServiceClient) SearchProjects(ctx context.Context, req *connect.
 	return c.searchProjects.CallUnary(ctx, req)
 }
 
+// FindSimilarProjects calls myawesomelist.v1.AwesomeService.FindSimilarProjects.
+func (c *awesomeServiceClient) FindSimilarProjects(ctx context.Context, req *connect.Request[v1.FindSimilarProjectsRequest]) (*connect.Response[v1.FindSimilarProjectsResponse], error) {
+	return c.findSimilarProjects.CallUnary(ctx, req)
+}
+
 // GetProjectStats calls myawesomelist.v1.AwesomeService.GetProjectStats.
 func (c *awesomeServiceClient) GetProjectStats(ctx context.Context, req *connect.Request[v1.GetProjectStatsRequest]) (*connect.Response[v1.GetProjectStatsResponse], error) {
 	return c.getProjectStats.CallUnary(ctx, req)
 }
 
+// GetProjectStatsHistory calls myawesomelist.v1.AwesomeService.GetProjectStatsHistory.
+func (c *awesomeServiceClient) GetProjectStatsHistory(ctx context.Context, req *connect.Request[v1.GetProjectStatsHistoryRequest]) (*connect.Response[v1.GetProjectStatsHistoryResponse], error) {
+	return c.getProjectStatsHistory.CallUnary(ctx, req)
+}
+
+// ListTrendingProjects calls myawesomelist.v1.AwesomeService.ListTrendingProjects.
+func (c *awesomeServiceClient) ListTrendingProjects(ctx context.Context, req *connect.Request[v1.ListTrendingProjectsRequest]) (*connect.Response[v1.ListTrendingProjectsResponse], error) {
+	return c.listTrendingProjects.CallUnary(ctx, req)
+}
+
+// ListDependencies calls myawesomelist.v1.AwesomeService.ListDependencies.
+func (c *awesomeServiceClient) ListDependencies(ctx context.Context, req *connect.Request[v1.ListDependenciesRequest]) (*connect.Response[v1.ListDependenciesResponse], error) {
+	return c.listDependencies.CallUnary(ctx, req)
+}
+
+// ListDependents calls myawesomelist.v1.AwesomeService.ListDependents.
+func (c *awesomeServiceClient) ListDependents(ctx context.Context, req *connect.Request[v1.ListDependentsRequest]) (*connect.Response[v1.ListDependentsResponse], error) {
+	return c.listDependents.CallUnary(ctx, req)
+}
+
 // AwesomeServiceHandler is an implementation of the myawesomelist.v1.AwesomeService service.
 type AwesomeServiceHandler interface {
 	ListCollections(context.Context, *connect.Request[v1.ListCollectionsRequest]) (*connect.Response[v1.ListCollectionsResponse], error)
 	GetCollection(context.Context, *connect.Request[v1.GetCollectionRequest]) (*connect.Response[v1.GetCollectionResponse], error)
+	ListCollectionChanges(context.Context, *connect.Request[v1.ListCollectionChangesRequest]) (*connect.Response[v1.ListCollectionChangesResponse], error)
 	ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error)
 	ListProjects(context.Context, *connect.Request[v1.ListProjectsRequest]) (*connect.Response[v1.ListProjectsResponse], error)
 	SearchProjects(context.Context, *connect.Request[v1.SearchProjectsRequest]) (*connect.Response[v1.SearchProjectsResponse], error)
+	FindSimilarProjects(context.Context, *connect.Request[v1.FindSimilarProjectsRequest]) (*connect.Response[v1.FindSimilarProjectsResponse], error)
 	GetProjectStats(context.Context, *connect.Request[v1.GetProjectStatsRequest]) (*connect.Response[v1.GetProjectStatsResponse], error)
+	GetProjectStatsHistory(context.Context, *connect.Request[v1.GetProjectStatsHistoryRequest]) (*connect.Response[v1.GetProjectStatsHistoryResponse], error)
+	ListTrendingProjects(context.Context, *connect.Request[v1.ListTrendingProjectsRequest]) (*connect.Response[v1.ListTrendingProjectsResponse], error)
+	ListDependencies(context.Context, *connect.Request[v1.ListDependenciesRequest]) (*connect.Response[v1.ListDependenciesResponse], error)
+	ListDependents(context.Context, *connect.Request[v1.ListDependentsRequest]) (*connect.Response[v1.ListDependentsResponse], error)
 }
 
 // NewAwesomeServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -182,6 +284,12 @@ func NewAwesomeServiceHandler(svc AwesomeServiceHandler, opts ...connect.Handler
 		connect.WithSchema(awesomeServiceMethods.ByName("GetCollection")),
 		connect.WithHandlerOptions(opts...),
 	)
+	awesomeServiceListCollectionChangesHandler := connect.NewUnaryHandler(
+		AwesomeServiceListCollectionChangesProcedure,
+		svc.ListCollectionChanges,
+		connect.WithSchema(awesomeServiceMethods.ByName("ListCollectionChanges")),
+		connect.WithHandlerOptions(opts...),
+	)
 	awesomeServiceListCategoriesHandler := connect.NewUnaryHandler(
 		AwesomeServiceListCategoriesProcedure,
 		svc.ListCategories,
@@ -200,26 +308,68 @@ func NewAwesomeServiceHandler(svc AwesomeServiceHandler, opts ...connect.Handler
 		connect.WithSchema(awesomeServiceMethods.ByName("SearchProjects")),
 		connect.WithHandlerOptions(opts...),
 	)
+	awesomeServiceFindSimilarProjectsHandler := connect.NewUnaryHandler(
+		AwesomeServiceFindSimilarProjectsProcedure,
+		svc.FindSimilarProjects,
+		connect.WithSchema(awesomeServiceMethods.ByName("FindSimilarProjects")),
+		connect.WithHandlerOptions(opts...),
+	)
 	awesomeServiceGetProjectStatsHandler := connect.NewUnaryHandler(
 		AwesomeServiceGetProjectStatsProcedure,
 		svc.GetProjectStats,
 		connect.WithSchema(awesomeServiceMethods.ByName("GetProjectStats")),
 		connect.WithHandlerOptions(opts...),
 	)
+	awesomeServiceGetProjectStatsHistoryHandler := connect.NewUnaryHandler(
+		AwesomeServiceGetProjectStatsHistoryProcedure,
+		svc.GetProjectStatsHistory,
+		connect.WithSchema(awesomeServiceMethods.ByName("GetProjectStatsHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	awesomeServiceListTrendingProjectsHandler := connect.NewUnaryHandler(
+		AwesomeServiceListTrendingProjectsProcedure,
+		svc.ListTrendingProjects,
+		connect.WithSchema(awesomeServiceMethods.ByName("ListTrendingProjects")),
+		connect.WithHandlerOptions(opts...),
+	)
+	awesomeServiceListDependenciesHandler := connect.NewUnaryHandler(
+		AwesomeServiceListDependenciesProcedure,
+		svc.ListDependencies,
+		connect.WithSchema(awesomeServiceMethods.ByName("ListDependencies")),
+		connect.WithHandlerOptions(opts...),
+	)
+	awesomeServiceListDependentsHandler := connect.NewUnaryHandler(
+		AwesomeServiceListDependentsProcedure,
+		svc.ListDependents,
+		connect.WithSchema(awesomeServiceMethods.ByName("ListDependents")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/myawesomelist.v1.AwesomeService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case AwesomeServiceListCollectionsProcedure:
 			awesomeServiceListCollectionsHandler.ServeHTTP(w, r)
 		case AwesomeServiceGetCollectionProcedure:
 			awesomeServiceGetCollectionHandler.ServeHTTP(w, r)
+		case AwesomeServiceListCollectionChangesProcedure:
+			awesomeServiceListCollectionChangesHandler.ServeHTTP(w, r)
 		case AwesomeServiceListCategoriesProcedure:
 			awesomeServiceListCategoriesHandler.ServeHTTP(w, r)
 		case AwesomeServiceListProjectsProcedure:
 			awesomeServiceListProjectsHandler.ServeHTTP(w, r)
 		case AwesomeServiceSearchProjectsProcedure:
 			awesomeServiceSearchProjectsHandler.ServeHTTP(w, r)
+		case AwesomeServiceFindSimilarProjectsProcedure:
+			awesomeServiceFindSimilarProjectsHandler.ServeHTTP(w, r)
 		case AwesomeServiceGetProjectStatsProcedure:
 			awesomeServiceGetProjectStatsHandler.ServeHTTP(w, r)
+		case AwesomeServiceGetProjectStatsHistoryProcedure:
+			awesomeServiceGetProjectStatsHistoryHandler.ServeHTTP(w, r)
+		case AwesomeServiceListTrendingProjectsProcedure:
+			awesomeServiceListTrendingProjectsHandler.ServeHTTP(w, r)
+		case AwesomeServiceListDependenciesProcedure:
+			awesomeServiceListDependenciesHandler.ServeHTTP(w, r)
+		case AwesomeServiceListDependentsProcedure:
+			awesomeServiceListDependentsHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -237,6 +387,10 @@ func (UnimplementedAwesomeServiceHandler) GetCollection(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myawesomelist.v1.AwesomeService.GetCollection is not implemented"))
 }
 
+func (UnimplementedAwesomeServiceHandler) ListCollectionChanges(context.Context, *connect.Request[v1.ListCollectionChangesRequest]) (*connect.Response[v1.ListCollectionChangesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myawesomelist.v1.AwesomeService.ListCollectionChanges is not implemented"))
+}
+
 func (UnimplementedAwesomeServiceHandler) ListCategories(context.Context, *connect.Request[v1.ListCategoriesRequest]) (*connect.Response[v1.ListCategoriesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myawesomelist.v1.AwesomeService.ListCategories is not implemented"))
 }
@@ -249,6 +403,26 @@ func (UnimplementedAwesomeServiceHandler) SearchProjects(context.Context, *conne
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myawesomelist.v1.AwesomeService.SearchProjects is not implemented"))
 }
 
+func (UnimplementedAwesomeServiceHandler) FindSimilarProjects(context.Context, *connect.Request[v1.FindSimilarProjectsRequest]) (*connect.Response[v1.FindSimilarProjectsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myawesomelist.v1.AwesomeService.FindSimilarProjects is not implemented"))
+}
+
 func (UnimplementedAwesomeServiceHandler) GetProjectStats(context.Context, *connect.Request[v1.GetProjectStatsRequest]) (*connect.Response[v1.GetProjectStatsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myawesomelist.v1.AwesomeService.GetProjectStats is not implemented"))
 }
+
+func (UnimplementedAwesomeServiceHandler) GetProjectStatsHistory(context.Context, *connect.Request[v1.GetProjectStatsHistoryRequest]) (*connect.Response[v1.GetProjectStatsHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myawesomelist.v1.AwesomeService.GetProjectStatsHistory is not implemented"))
+}
+
+func (UnimplementedAwesomeServiceHandler) ListTrendingProjects(context.Context, *connect.Request[v1.ListTrendingProjectsRequest]) (*connect.Response[v1.ListTrendingProjectsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myawesomelist.v1.AwesomeService.ListTrendingProjects is not implemented"))
+}
+
+func (UnimplementedAwesomeServiceHandler) ListDependencies(context.Context, *connect.Request[v1.ListDependenciesRequest]) (*connect.Response[v1.ListDependenciesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myawesomelist.v1.AwesomeService.ListDependencies is not implemented"))
+}
+
+func (UnimplementedAwesomeServiceHandler) ListDependents(context.Context, *connect.Request[v1.ListDependentsRequest]) (*connect.Response[v1.ListDependentsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("myawesomelist.v1.AwesomeService.ListDependents is not implemented"))
+}
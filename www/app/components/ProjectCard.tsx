@@ -6,7 +6,17 @@ import {
 } from "~/routes/projects.stats";
 import { z } from "zod";
 import { useFetcher } from "react-router";
-import type { Project } from "~/proto/myawesomelist/v1/myawesomelist_pb";
+import {
+  RepositoryStatus,
+  type Project,
+} from "~/proto/myawesomelist/v1/myawesomelist_pb";
+
+const unavailableStatusLabels: Partial<Record<RepositoryStatus, string>> = {
+  [RepositoryStatus.ARCHIVED]: "Archived",
+  [RepositoryStatus.DISABLED]: "Disabled",
+  [RepositoryStatus.NOT_FOUND]: "Not found",
+  [RepositoryStatus.BLOCKED]: "Blocked",
+};
 
 export function ProjectCard({ project }: { project: Project }) {
   const [stats, setStats] = useState<z.infer<typeof ProjectStatsSchema> | null>(
@@ -17,6 +27,9 @@ export function ProjectCard({ project }: { project: Project }) {
 
   useTimeout(() => setErrorToast(null), errorToast ? 4000 : null);
 
+  const statusLabel = unavailableStatusLabels[stats?.status ?? project.status];
+  const latestRelease = project.latestRelease;
+
   const { ref, isIntersecting } = useIntersectionObserver({
     threshold: 0.2,
     freezeOnceVisible: true,
@@ -64,10 +77,33 @@ export function ProjectCard({ project }: { project: Project }) {
           <div>
             <h3 className="text-xl font-semibold text-gray-900 dark:text-white mb-2">
               {project.name}
+              {statusLabel && (
+                <span className="badge badge-warning badge-sm ml-2">
+                  {statusLabel}
+                </span>
+              )}
+              {stats?.busFactor === 1 && (
+                <span
+                  className="badge badge-warning badge-sm ml-2"
+                  title="Half of the commits come from a single contributor"
+                >
+                  Single maintainer
+                </span>
+              )}
             </h3>
             <p className="text-gray-600 dark:text-gray-300 mb-4">
               {project.description}
             </p>
+            {latestRelease && (
+              <p className="text-sm text-gray-500 dark:text-gray-400 mb-4">
+                Latest release {latestRelease.tagName}
+                {latestRelease.prerelease && " (pre-release)"}
+                {latestRelease.publishedAt &&
+                  ` on ${new Date(
+                    Number(latestRelease.publishedAt.seconds) * 1000,
+                  ).toLocaleDateString()}`}
+              </p>
+            )}
           </div>
           <div className="flex space-x-2 items-center">
             {fetcher.state === "loading" && (
@@ -94,6 +130,16 @@ export function ProjectCard({ project }: { project: Project }) {
           ) : (
             <span>⭐ —</span>
           )}
+          {project.healthScore !== undefined && (
+            <span title="Composite health score (0-100)">
+              ♥ {Math.round(project.healthScore)}
+            </span>
+          )}
+          {project.dependentsCount > 0 && (
+            <span title="Listed projects depending on this one">
+              {project.dependentsCount} dependents
+            </span>
+          )}
           {fetcher.state === "loading" ? (
             <span className="skeleton h-4 w-24"></span>
           ) : stats?.openIssueCount ? (
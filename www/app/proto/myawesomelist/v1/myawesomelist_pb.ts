@@ -3,11 +3,13 @@
 /* eslint-disable */
 
 import type {
+  GenEnum,
   GenFile,
   GenMessage,
   GenService,
 } from "@bufbuild/protobuf/codegenv2";
 import {
+  enumDesc,
   fileDesc,
   messageDesc,
   serviceDesc,
@@ -22,7 +24,7 @@ import type { Message } from "@bufbuild/protobuf";
 export const file_myawesomelist_v1_myawesomelist: GenFile =
   /*@__PURE__*/
   fileDesc(
-    "CiRteWF3ZXNvbWVsaXN0L3YxL215YXdlc29tZWxpc3QucHJvdG8SEG15YXdlc29tZWxpc3QudjEisgEKDFByb2plY3RTdGF0cxIKCgJpZBgBIAEoBBIdChBzdGFyZ2F6ZXJzX2NvdW50GAIgASgNSACIAQESHQoQb3Blbl9pc3N1ZV9jb3VudBgDIAEoDUgBiAEBEi4KCnVwZGF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wQhMKEV9zdGFyZ2F6ZXJzX2NvdW50QhMKEV9vcGVuX2lzc3VlX2NvdW50IpQBCgdQcm9qZWN0EgoKAmlkGAEgASgEEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSKgoEcmVwbxgEIAEoCzIcLm15YXdlc29tZWxpc3QudjEuUmVwb3NpdG9yeRIuCgp1cGRhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCKBAQoIQ2F0ZWdvcnkSCgoCaWQYASABKAQSDAoEbmFtZRgCIAEoCRIrCghwcm9qZWN0cxgDIAMoCzIZLm15YXdlc29tZWxpc3QudjEuUHJvamVjdBIuCgp1cGRhdGVkX2F0GAQgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCK2AQoKQ29sbGVjdGlvbhIKCgJpZBgBIAEoBBIQCghsYW5ndWFnZRgCIAEoCRIqCgRyZXBvGAMgASgLMhwubXlhd2Vzb21lbGlzdC52MS5SZXBvc2l0b3J5Ei4KCmNhdGVnb3JpZXMYBCADKAsyGi5teWF3ZXNvbWVsaXN0LnYxLkNhdGVnb3J5Ei4KCnVwZGF0ZWRfYXQYBSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIjsKClJlcG9zaXRvcnkSEAoIaG9zdG5hbWUYASABKAkSDQoFb3duZXIYAiABKAkSDAoEcmVwbxgDIAEoCSJFChZMaXN0Q29sbGVjdGlvbnNSZXF1ZXN0EisKBXJlcG9zGAEgAygLMhwubXlhd2Vzb21lbGlzdC52MS5SZXBvc2l0b3J5IkwKF0xpc3RDb2xsZWN0aW9uc1Jlc3BvbnNlEjEKC2NvbGxlY3Rpb25zGAEgAygLMhwubXlhd2Vzb21lbGlzdC52MS5Db2xsZWN0aW9uIkIKFEdldENvbGxlY3Rpb25SZXF1ZXN0EioKBHJlcG8YASABKAsyHC5teWF3ZXNvbWVsaXN0LnYxLlJlcG9zaXRvcnkiSQoVR2V0Q29sbGVjdGlvblJlc3BvbnNlEjAKCmNvbGxlY3Rpb24YASABKAsyHC5teWF3ZXNvbWVsaXN0LnYxLkNvbGxlY3Rpb24iQwoVTGlzdENhdGVnb3JpZXNSZXF1ZXN0EioKBHJlcG8YASABKAsyHC5teWF3ZXNvbWVsaXN0LnYxLlJlcG9zaXRvcnkiSAoWTGlzdENhdGVnb3JpZXNSZXNwb25zZRIuCgpjYXRlZ29yaWVzGAEgAygLMhoubXlhd2Vzb21lbGlzdC52MS5DYXRlZ29yeSJYChNMaXN0UHJvamVjdHNSZXF1ZXN0EioKBHJlcG8YASABKAsyHC5teWF3ZXNvbWVsaXN0LnYxLlJlcG9zaXRvcnkSFQoNY2F0ZWdvcnlfbmFtZRgCIAEoCSJDChRMaXN0UHJvamVjdHNSZXNwb25zZRIrCghwcm9qZWN0cxgBIAMoCzIZLm15YXdlc29tZWxpc3QudjEuUHJvamVjdCJiChVTZWFyY2hQcm9qZWN0c1JlcXVlc3QSDQoFcXVlcnkYASABKAkSDQoFbGltaXQYAiABKA0SKwoFcmVwb3MYAyADKAsyHC5teWF3ZXNvbWVsaXN0LnYxLlJlcG9zaXRvcnkiRQoWU2VhcmNoUHJvamVjdHNSZXNwb25zZRIrCghwcm9qZWN0cxgBIAMoCzIZLm15YXdlc29tZWxpc3QudjEuUHJvamVjdCJEChZHZXRQcm9qZWN0U3RhdHNSZXF1ZXN0EioKBHJlcG8YASABKAsyHC5teWF3ZXNvbWVsaXN0LnYxLlJlcG9zaXRvcnkiSAoXR2V0UHJvamVjdFN0YXRzUmVzcG9uc2USLQoFc3RhdHMYASABKAsyHi5teWF3ZXNvbWVsaXN0LnYxLlByb2plY3RTdGF0czLrBAoOQXdlc29tZVNlcnZpY2USZgoPTGlzdENvbGxlY3Rpb25zEigubXlhd2Vzb21lbGlzdC52MS5MaXN0Q29sbGVjdGlvbnNSZXF1ZXN0GikubXlhd2Vzb21lbGlzdC52MS5MaXN0Q29sbGVjdGlvbnNSZXNwb25zZRJgCg1HZXRDb2xsZWN0aW9uEiYubXlhd2Vzb21lbGlzdC52MS5HZXRDb2xsZWN0aW9uUmVxdWVzdBonLm15YXdlc29tZWxpc3QudjEuR2V0Q29sbGVjdGlvblJlc3BvbnNlEmMKDkxpc3RDYXRlZ29yaWVzEicubXlhd2Vzb21lbGlzdC52MS5MaXN0Q2F0ZWdvcmllc1JlcXVlc3QaKC5teWF3ZXNvbWVsaXN0LnYxLkxpc3RDYXRlZ29yaWVzUmVzcG9uc2USXQoMTGlzdFByb2plY3RzEiUubXlhd2Vzb21lbGlzdC52MS5MaXN0UHJvamVjdHNSZXF1ZXN0GiYubXlhd2Vzb21lbGlzdC52MS5MaXN0UHJvamVjdHNSZXNwb25zZRJjCg5TZWFyY2hQcm9qZWN0cxInLm15YXdlc29tZWxpc3QudjEuU2VhcmNoUHJvamVjdHNSZXF1ZXN0GigubXlhd2Vzb21lbGlzdC52MS5TZWFyY2hQcm9qZWN0c1Jlc3BvbnNlEmYKD0dldFByb2plY3RTdGF0cxIoLm15YXdlc29tZWxpc3QudjEuR2V0UHJvamVjdFN0YXRzUmVxdWVzdBopLm15YXdlc29tZWxpc3QudjEuR2V0UHJvamVjdFN0YXRzUmVzcG9uc2VCTFpKbXlhd2Vzb21lbGlzdC5zaGlrYW5pbWUuc3R1ZGlvL3BrZ3MvcHJvdG8vbXlhd2Vzb21lbGlzdC92MTtteWF3ZXNvbWVsaXN0djFiBnByb3RvMw",
+    "CiRteWF3ZXNvbWVsaXN0L3YxL215YXdlc29tZWxpc3QucHJvdG8SEG15YXdlc29tZWxpc3QudjEiqwcKDFByb2plY3RTdGF0cxIKCgJpZBgBIAEoBBIdChBzdGFyZ2F6ZXJzX2NvdW50GAIgASgNSACIAQESHQoQb3Blbl9pc3N1ZV9jb3VudBgDIAEoDUgBiAEBEi4KCnVwZGF0ZWRfYXQYBCABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjIKBnN0YXR1cxgFIAEoDjIiLm15YXdlc29tZWxpc3QudjEuUmVwb3NpdG9yeVN0YXR1cxI1ChFzdGF0dXNfY2hlY2tlZF9hdBgGIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASGAoLZm9ya3NfY291bnQYByABKA1IAogBARIeChFzdWJzY3JpYmVyc19jb3VudBgIIAEoDUgDiAEBEg8KB2xpY2Vuc2UYCSABKAkSDgoGdG9waWNzGAogAygJEhAKCGxhbmd1YWdlGAsgASgJEhYKDmRlZmF1bHRfYnJhbmNoGAwgASgJEi4KCmNyZWF0ZWRfYXQYDSABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEi0KCXB1c2hlZF9hdBgOIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEAoIYXJjaGl2ZWQYDyABKAgSMQoObGF0ZXN0X3JlbGVhc2UYECABKAsyGS5teWF3ZXNvbWVsaXN0LnYxLlJlbGVhc2USIQoUcmVsZWFzZV9jYWRlbmNlX2RheXMYESABKAFIBIgBARIZCgxoZWFsdGhfc2NvcmUYEiABKAFIBYgBARIfChJjb250cmlidXRvcnNfY291bnQYEyABKA1IBogBARIiChV0b3BfY29udHJpYnV0b3Jfc2hhcmUYFCABKAFIB4gBARIXCgpidXNfZmFjdG9yGBUgASgNSAiIAQESNwoOcmVnaXN0cnlfc3RhdHMYFiADKAsyHy5teWF3ZXNvbWVsaXN0LnYxLlJlZ2lzdHJ5U3RhdHNCEwoRX3N0YXJnYXplcnNfY291bnRCEwoRX29wZW5faXNzdWVfY291bnRCDgoMX2ZvcmtzX2NvdW50QhQKEl9zdWJzY3JpYmVyc19jb3VudEIXChVfcmVsZWFzZV9jYWRlbmNlX2RheXNCDwoNX2hlYWx0aF9zY29yZUIVChNfY29udHJpYnV0b3JzX2NvdW50QhgKFl90b3BfY29udHJpYnV0b3Jfc2hhcmVCDQoLX2J1c19mYWN0b3Ii6gEKDVJlZ2lzdHJ5U3RhdHMSEAoIcmVnaXN0cnkYASABKAkSDwoHcGFja2FnZRgCIAEoCRIWCglkb3dubG9hZHMYAyABKARIAIgBARIYChBkb3dubG9hZHNfcGVyaW9kGAQgASgJEhYKDmxhdGVzdF92ZXJzaW9uGAUgASgJEhsKDnZlcnNpb25zX2NvdW50GAYgASgNSAGIAQESLgoKdXBkYXRlZF9hdBgHIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXBCDAoKX2Rvd25sb2Fkc0IRCg9fdmVyc2lvbnNfY291bnQifAoHUmVsZWFzZRIQCgh0YWdfbmFtZRgBIAEoCRIMCgRuYW1lGAIgASgJEjAKDHB1Ymxpc2hlZF9hdBgDIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKcHJlcmVsZWFzZRgEIAEoCBILCgN1cmwYBSABKAkijAIKEVByb2plY3RTdGF0c1BvaW50Ei8KC3JlY29yZGVkX2F0GAEgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIdChBzdGFyZ2F6ZXJzX2NvdW50GAIgASgNSACIAQESHQoQb3Blbl9pc3N1ZV9jb3VudBgDIAEoDUgBiAEBEhgKC2ZvcmtzX2NvdW50GAQgASgNSAKIAQESHgoRc3Vic2NyaWJlcnNfY291bnQYBSABKA1IA4gBAUITChFfc3RhcmdhemVyc19jb3VudEITChFfb3Blbl9pc3N1ZV9jb3VudEIOCgxfZm9ya3NfY291bnRCFAoSX3N1YnNjcmliZXJzX2NvdW50IqQDCgdQcm9qZWN0EgoKAmlkGAEgASgEEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSKgoEcmVwbxgEIAEoCzIcLm15YXdlc29tZWxpc3QudjEuUmVwb3NpdG9yeRIuCgp1cGRhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIyCgZzdGF0dXMYBiABKA4yIi5teWF3ZXNvbWVsaXN0LnYxLlJlcG9zaXRvcnlTdGF0dXMSMQoObGF0ZXN0X3JlbGVhc2UYByABKAsyGS5teWF3ZXNvbWVsaXN0LnYxLlJlbGVhc2USGQoMaGVhbHRoX3Njb3JlGAggASgBSACIAQESGAoQZGVwZW5kZW50c19jb3VudBgJIAEoDRIuCgpyZW1vdmVkX2F0GAogASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcBIxCg1maXJzdF9zZWVuX2F0GAsgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcEIPCg1faGVhbHRoX3Njb3JlImAKDkRlcGVuZGVuY3lFZGdlEioKBHJlcG8YASABKAsyHC5teWF3ZXNvbWVsaXN0LnYxLlJlcG9zaXRvcnkSEQoJZWNvc3lzdGVtGAIgASgJEg8KB3BhY2thZ2UYAyABKAkimgEKD1RyZW5kaW5nUHJvamVjdBIqCgdwcm9qZWN0GAEgASgLMhkubXlhd2Vzb21lbGlzdC52MS5Qcm9qZWN0Eh0KEHN0YXJnYXplcnNfY291bnQYAiABKA1IAIgBARIYChBzdGFyZ2F6ZXJzX2RlbHRhGAMgASgFEg0KBXNjb3JlGAQgASgBQhMKEV9zdGFyZ2F6ZXJzX2NvdW50ImAKDlNpbWlsYXJQcm9qZWN0EioKB3Byb2plY3QYASABKAsyGS5teWF3ZXNvbWVsaXN0LnYxLlByb2plY3QSEAoIZGlzdGFuY2UYAiABKAESEAoIbGFuZ3VhZ2UYAyABKAkisQEKCENhdGVnb3J5EgoKAmlkGAEgASgEEgwKBG5hbWUYAiABKAkSKwoIcHJvamVjdHMYAyADKAsyGS5teWF3ZXNvbWVsaXN0LnYxLlByb2plY3QSLgoKdXBkYXRlZF9hdBgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLgoKcmVtb3ZlZF9hdBgFIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAitgEKCkNvbGxlY3Rpb24SCgoCaWQYASABKAQSEAoIbGFuZ3VhZ2UYAiABKAkSKgoEcmVwbxgDIAEoCzIcLm15YXdlc29tZWxpc3QudjEuUmVwb3NpdG9yeRIuCgpjYXRlZ29yaWVzGAQgAygLMhoubXlhd2Vzb21lbGlzdC52MS5DYXRlZ29yeRIuCgp1cGRhdGVkX2F0GAUgASgLMhouZ29vZ2xlLnByb3RvYnVmLlRpbWVzdGFtcCLtAgoPQ29sbGVjdGlvbkV2ZW50EgoKAmlkGAEgASgEEjMKBHR5cGUYAiABKA4yJS5teWF3ZXNvbWVsaXN0LnYxLkNvbGxlY3Rpb25FdmVudFR5cGUSNQoPY29sbGVjdGlvbl9yZXBvGAMgASgLMhwubXlhd2Vzb21lbGlzdC52MS5SZXBvc2l0b3J5EjIKDHByb2plY3RfcmVwbxgEIAEoCzIcLm15YXdlc29tZWxpc3QudjEuUmVwb3NpdG9yeRIUCgxwcm9qZWN0X25hbWUYBSABKAkSFQoNY2F0ZWdvcnlfbmFtZRgGIAEoCRIeChZwcmV2aW91c19jYXRlZ29yeV9uYW1lGAcgASgJEhMKC2Rlc2NyaXB0aW9uGAggASgJEhwKFHByZXZpb3VzX2Rlc2NyaXB0aW9uGAkgASgJEi4KCmNyZWF0ZWRfYXQYCiABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wIjsKClJlcG9zaXRvcnkSEAoIaG9zdG5hbWUYASABKAkSDQoFb3duZXIYAiABKAkSDAoEcmVwbxgDIAEoCSJsChZMaXN0Q29sbGVjdGlvbnNSZXF1ZXN0EisKBXJlcG9zGAEgAygLMhwubXlhd2Vzb21lbGlzdC52MS5SZXBvc2l0b3J5EhEKCXBhZ2Vfc2l6ZRgCIAEoDRISCgpwYWdlX3Rva2VuGAMgASgJInkKF0xpc3RDb2xsZWN0aW9uc1Jlc3BvbnNlEjEKC2NvbGxlY3Rpb25zGAEgAygLMhwubXlhd2Vzb21lbGlzdC52MS5Db2xsZWN0aW9uEhcKD25leHRfcGFnZV90b2tlbhgCIAEoCRISCgp0b3RhbF9zaXplGAMgASgNIlsKFEdldENvbGxlY3Rpb25SZXF1ZXN0EioKBHJlcG8YASABKAsyHC5teWF3ZXNvbWVsaXN0LnYxLlJlcG9zaXRvcnkSFwoPaW5jbHVkZV9yZW1vdmVkGAIgASgIIkkKFUdldENvbGxlY3Rpb25SZXNwb25zZRIwCgpjb2xsZWN0aW9uGAEgASgLMhwubXlhd2Vzb21lbGlzdC52MS5Db2xsZWN0aW9uImoKFUxpc3RDYXRlZ29yaWVzUmVxdWVzdBIqCgRyZXBvGAEgASgLMhwubXlhd2Vzb21lbGlzdC52MS5SZXBvc2l0b3J5EhEKCXBhZ2Vfc2l6ZRgCIAEoDRISCgpwYWdlX3Rva2VuGAMgASgJInUKFkxpc3RDYXRlZ29yaWVzUmVzcG9uc2USLgoKY2F0ZWdvcmllcxgBIAMoCzIaLm15YXdlc29tZWxpc3QudjEuQ2F0ZWdvcnkSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhIKCnRvdGFsX3NpemUYAyABKA0iswEKE0xpc3RQcm9qZWN0c1JlcXVlc3QSKgoEcmVwbxgBIAEoCzIcLm15YXdlc29tZWxpc3QudjEuUmVwb3NpdG9yeRIVCg1jYXRlZ29yeV9uYW1lGAIgASgJEjIKCG9yZGVyX2J5GAMgASgOMiAubXlhd2Vzb21lbGlzdC52MS5Qcm9qZWN0T3JkZXJCeRIRCglwYWdlX3NpemUYBCABKA0SEgoKcGFnZV90b2tlbhgFIAEoCSJwChRMaXN0UHJvamVjdHNSZXNwb25zZRIrCghwcm9qZWN0cxgBIAMoCzIZLm15YXdlc29tZWxpc3QudjEuUHJvamVjdBIXCg9uZXh0X3BhZ2VfdG9rZW4YAiABKAkSEgoKdG90YWxfc2l6ZRgDIAEoDSLfAwoVU2VhcmNoUHJvamVjdHNSZXF1ZXN0Eg0KBXF1ZXJ5GAEgASgJEg0KBWxpbWl0GAIgASgNEisKBXJlcG9zGAMgAygLMhwubXlhd2Vzb21lbGlzdC52MS5SZXBvc2l0b3J5EhcKD2luY2x1ZGVfcmVtb3ZlZBgEIAEoCBIcCg9zZW1hbnRpY193ZWlnaHQYBSABKAFIAIgBARIbCg5sZXhpY2FsX3dlaWdodBgGIAEoAUgBiAEBEhAKCGxhbmd1YWdlGAcgASgJEhAKCGNhdGVnb3J5GAggASgJEhYKCW1pbl9zdGFycxgJIAEoDUgCiAEBEhYKCW1heF9zdGFycxgKIAEoDUgDiAEBEjAKDHB1c2hlZF9hZnRlchgLIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASDwoHbGljZW5zZRgMIAEoCRIMCgRob3N0GA0gASgJEhgKEGV4Y2x1ZGVfYXJjaGl2ZWQYDiABKAgSEQoJcGFnZV9zaXplGA8gASgNEhIKCnBhZ2VfdG9rZW4YECABKAlCEgoQX3NlbWFudGljX3dlaWdodEIRCg9fbGV4aWNhbF93ZWlnaHRCDAoKX21pbl9zdGFyc0IMCgpfbWF4X3N0YXJzInIKFlNlYXJjaFByb2plY3RzUmVzcG9uc2USKwoIcHJvamVjdHMYASADKAsyGS5teWF3ZXNvbWVsaXN0LnYxLlByb2plY3QSFwoPbmV4dF9wYWdlX3Rva2VuGAIgASgJEhIKCnRvdGFsX3NpemUYAyABKA0iRAoWR2V0UHJvamVjdFN0YXRzUmVxdWVzdBIqCgRyZXBvGAEgASgLMhwubXlhd2Vzb21lbGlzdC52MS5SZXBvc2l0b3J5IkgKF0dldFByb2plY3RTdGF0c1Jlc3BvbnNlEi0KBXN0YXRzGAEgASgLMh4ubXlhd2Vzb21lbGlzdC52MS5Qcm9qZWN0U3RhdHMi3AEKHUdldFByb2plY3RTdGF0c0hpc3RvcnlSZXF1ZXN0EioKBHJlcG8YASABKAsyHC5teWF3ZXNvbWVsaXN0LnYxLlJlcG9zaXRvcnkSLgoKc3RhcnRfdGltZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEjEKCGludGVydmFsGAQgASgOMh8ubXlhd2Vzb21lbGlzdC52MS5TdGF0c0ludGVydmFsIlUKHkdldFByb2plY3RTdGF0c0hpc3RvcnlSZXNwb25zZRIzCgZwb2ludHMYASADKAsyIy5teWF3ZXNvbWVsaXN0LnYxLlByb2plY3RTdGF0c1BvaW50IrMBChtMaXN0VHJlbmRpbmdQcm9qZWN0c1JlcXVlc3QSMAoGd2luZG93GAEgASgOMiAubXlhd2Vzb21lbGlzdC52MS5UcmVuZGluZ1dpbmRvdxIqCgRyZXBvGAIgASgLMhwubXlhd2Vzb21lbGlzdC52MS5SZXBvc2l0b3J5EhUKDWNhdGVnb3J5X25hbWUYAyABKAkSEAoIbGFuZ3VhZ2UYBCABKAkSDQoFbGltaXQYBSABKA0iUwocTGlzdFRyZW5kaW5nUHJvamVjdHNSZXNwb25zZRIzCghwcm9qZWN0cxgBIAMoCzIhLm15YXdlc29tZWxpc3QudjEuVHJlbmRpbmdQcm9qZWN0IrcBChxMaXN0Q29sbGVjdGlvbkNoYW5nZXNSZXF1ZXN0EioKBHJlcG8YASABKAsyHC5teWF3ZXNvbWVsaXN0LnYxLlJlcG9zaXRvcnkSLgoKc3RhcnRfdGltZRgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASLAoIZW5kX3RpbWUYAyABKAsyGi5nb29nbGUucHJvdG9idWYuVGltZXN0YW1wEg0KBWxpbWl0GAQgASgNIlIKHUxpc3RDb2xsZWN0aW9uQ2hhbmdlc1Jlc3BvbnNlEjEKBmV2ZW50cxgBIAMoCzIhLm15YXdlc29tZWxpc3QudjEuQ29sbGVjdGlvbkV2ZW50Ip4BChpGaW5kU2ltaWxhclByb2plY3RzUmVxdWVzdBISCgpwcm9qZWN0X2lkGAEgASgEEioKBHJlcG8YAiABKAsyHC5teWF3ZXNvbWVsaXN0LnYxLlJlcG9zaXRvcnkSHgoWb3RoZXJfY29sbGVjdGlvbnNfb25seRgDIAEoCBIRCglsYW5ndWFnZXMYBCADKAkSDQoFbGltaXQYBSABKA0iUQobRmluZFNpbWlsYXJQcm9qZWN0c1Jlc3BvbnNlEjIKCHByb2plY3RzGAEgAygLMiAubXlhd2Vzb21lbGlzdC52MS5TaW1pbGFyUHJvamVjdCJFChdMaXN0RGVwZW5kZW5jaWVzUmVxdWVzdBIqCgRyZXBvGAEgASgLMhwubXlhd2Vzb21lbGlzdC52MS5SZXBvc2l0b3J5IlIKGExpc3REZXBlbmRlbmNpZXNSZXNwb25zZRI2CgxkZXBlbmRlbmNpZXMYASADKAsyIC5teWF3ZXNvbWVsaXN0LnYxLkRlcGVuZGVuY3lFZGdlIkMKFUxpc3REZXBlbmRlbnRzUmVxdWVzdBIqCgRyZXBvGAEgASgLMhwubXlhd2Vzb21lbGlzdC52MS5SZXBvc2l0b3J5Ik4KFkxpc3REZXBlbmRlbnRzUmVzcG9uc2USNAoKZGVwZW5kZW50cxgBIAMoCzIgLm15YXdlc29tZWxpc3QudjEuRGVwZW5kZW5jeUVkZ2Uq0wEKEFJlcG9zaXRvcnlTdGF0dXMSIQodUkVQT1NJVE9SWV9TVEFUVVNfVU5TUEVDSUZJRUQQABIcChhSRVBPU0lUT1JZX1NUQVRVU19BQ1RJVkUQARIeChpSRVBPU0lUT1JZX1NUQVRVU19BUkNISVZFRBACEh4KGlJFUE9TSVRPUllfU1RBVFVTX0RJU0FCTEVEEAMSHwobUkVQT1NJVE9SWV9TVEFUVVNfTk9UX0ZPVU5EEAQSHQoZUkVQT1NJVE9SWV9TVEFUVVNfQkxPQ0tFRBAFKpMBCg1TdGF0c0ludGVydmFsEh4KGlNUQVRTX0lOVEVSVkFMX1VOU1BFQ0lGSUVEEAASFwoTU1RBVFNfSU5URVJWQUxfSE9VUhABEhYKElNUQVRTX0lOVEVSVkFMX0RBWRACEhcKE1NUQVRTX0lOVEVSVkFMX1dFRUsQAxIYChRTVEFUU19JTlRFUlZBTF9NT05USBAEKn8KDlRyZW5kaW5nV2luZG93Eh8KG1RSRU5ESU5HX1dJTkRPV19VTlNQRUNJRklFRBAAEhcKE1RSRU5ESU5HX1dJTkRPV19EQVkQARIYChRUUkVORElOR19XSU5ET1dfV0VFSxACEhkKFVRSRU5ESU5HX1dJTkRPV19NT05USBADKu8BChNDb2xsZWN0aW9uRXZlbnRUeXBlEiUKIUNPTExFQ1RJT05fRVZFTlRfVFlQRV9VTlNQRUNJRklFRBAAEicKI0NPTExFQ1RJT05fRVZFTlRfVFlQRV9QUk9KRUNUX0FEREVEEAESKQolQ09MTEVDVElPTl9FVkVOVF9UWVBFX1BST0pFQ1RfUkVNT1ZFRBACEicKI0NPTExFQ1RJT05fRVZFTlRfVFlQRV9QUk9KRUNUX01PVkVEEAMSNAowQ09MTEVDVElPTl9FVkVOVF9UWVBFX1BST0pFQ1RfREVTQ1JJUFRJT05fRURJVEVEEAQqvAEKDlByb2plY3RPcmRlckJ5EiAKHFBST0pFQ1RfT1JERVJfQllfVU5TUEVDSUZJRUQQABIZChVQUk9KRUNUX09SREVSX0JZX05BTUUQARIhCh1QUk9KRUNUX09SREVSX0JZX0hFQUxUSF9TQ09SRRACEiUKIVBST0pFQ1RfT1JERVJfQllfREVQRU5ERU5UU19DT1VOVBADEiMKH1BST0pFQ1RfT1JERVJfQllfUkVDRU5UTFlfQURERUQQBDKdCgoOQXdlc29tZVNlcnZpY2USZgoPTGlzdENvbGxlY3Rpb25zEigubXlhd2Vzb21lbGlzdC52MS5MaXN0Q29sbGVjdGlvbnNSZXF1ZXN0GikubXlhd2Vzb21lbGlzdC52MS5MaXN0Q29sbGVjdGlvbnNSZXNwb25zZRJgCg1HZXRDb2xsZWN0aW9uEiYubXlhd2Vzb21lbGlzdC52MS5HZXRDb2xsZWN0aW9uUmVxdWVzdBonLm15YXdlc29tZWxpc3QudjEuR2V0Q29sbGVjdGlvblJlc3BvbnNlEngKFUxpc3RDb2xsZWN0aW9uQ2hhbmdlcxIuLm15YXdlc29tZWxpc3QudjEuTGlzdENvbGxlY3Rpb25DaGFuZ2VzUmVxdWVzdBovLm15YXdlc29tZWxpc3QudjEuTGlzdENvbGxlY3Rpb25DaGFuZ2VzUmVzcG9uc2USYwoOTGlzdENhdGVnb3JpZXMSJy5teWF3ZXNvbWVsaXN0LnYxLkxpc3RDYXRlZ29yaWVzUmVxdWVzdBooLm15YXdlc29tZWxpc3QudjEuTGlzdENhdGVnb3JpZXNSZXNwb25zZRJdCgxMaXN0UHJvamVjdHMSJS5teWF3ZXNvbWVsaXN0LnYxLkxpc3RQcm9qZWN0c1JlcXVlc3QaJi5teWF3ZXNvbWVsaXN0LnYxLkxpc3RQcm9qZWN0c1Jlc3BvbnNlEmMKDlNlYXJjaFByb2plY3RzEicubXlhd2Vzb21lbGlzdC52MS5TZWFyY2hQcm9qZWN0c1JlcXVlc3QaKC5teWF3ZXNvbWVsaXN0LnYxLlNlYXJjaFByb2plY3RzUmVzcG9uc2UScgoTRmluZFNpbWlsYXJQcm9qZWN0cxIsLm15YXdlc29tZWxpc3QudjEuRmluZFNpbWlsYXJQcm9qZWN0c1JlcXVlc3QaLS5teWF3ZXNvbWVsaXN0LnYxLkZpbmRTaW1pbGFyUHJvamVjdHNSZXNwb25zZRJmCg9HZXRQcm9qZWN0U3RhdHMSKC5teWF3ZXNvbWVsaXN0LnYxLkdldFByb2plY3RTdGF0c1JlcXVlc3QaKS5teWF3ZXNvbWVsaXN0LnYxLkdldFByb2plY3RTdGF0c1Jlc3BvbnNlEnsKFkdldFByb2plY3RTdGF0c0hpc3RvcnkSLy5teWF3ZXNvbWVsaXN0LnYxLkdldFByb2plY3RTdGF0c0hpc3RvcnlSZXF1ZXN0GjAubXlhd2Vzb21lbGlzdC52MS5HZXRQcm9qZWN0U3RhdHNIaXN0b3J5UmVzcG9uc2USdQoUTGlzdFRyZW5kaW5nUHJvamVjdHMSLS5teWF3ZXNvbWVsaXN0LnYxLkxpc3RUcmVuZGluZ1Byb2plY3RzUmVxdWVzdBouLm15YXdlc29tZWxpc3QudjEuTGlzdFRyZW5kaW5nUHJvamVjdHNSZXNwb25zZRJpChBMaXN0RGVwZW5kZW5jaWVzEikubXlhd2Vzb21lbGlzdC52MS5MaXN0RGVwZW5kZW5jaWVzUmVxdWVzdBoqLm15YXdlc29tZWxpc3QudjEuTGlzdERlcGVuZGVuY2llc1Jlc3BvbnNlEmMKDkxpc3REZXBlbmRlbnRzEicubXlhd2Vzb21lbGlzdC52MS5MaXN0RGVwZW5kZW50c1JlcXVlc3QaKC5teWF3ZXNvbWVsaXN0LnYxLkxpc3REZXBlbmRlbnRzUmVzcG9uc2VCTFpKbXlhd2Vzb21lbGlzdC5zaGlrYW5pbWUuc3R1ZGlvL3BrZ3MvcHJvdG8vbXlhd2Vzb21lbGlzdC92MTtteWF3ZXNvbWVsaXN0djFiBnByb3RvMw",
     [file_google_protobuf_timestamp],
   );
 
@@ -51,6 +53,108 @@ export type ProjectStats = Message<"myawesomelist.v1.ProjectStats"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 4;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: myawesomelist.v1.RepositoryStatus status = 5;
+   */
+  status: RepositoryStatus;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp status_checked_at = 6;
+   */
+  statusCheckedAt?: Timestamp;
+
+  /**
+   * @generated from field: optional uint32 forks_count = 7;
+   */
+  forksCount?: number;
+
+  /**
+   * @generated from field: optional uint32 subscribers_count = 8;
+   */
+  subscribersCount?: number;
+
+  /**
+   * SPDX identifier of the repository license
+   *
+   * @generated from field: string license = 9;
+   */
+  license: string;
+
+  /**
+   * @generated from field: repeated string topics = 10;
+   */
+  topics: string[];
+
+  /**
+   * Primary language reported by the host
+   *
+   * @generated from field: string language = 11;
+   */
+  language: string;
+
+  /**
+   * @generated from field: string default_branch = 12;
+   */
+  defaultBranch: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 13;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp pushed_at = 14;
+   */
+  pushedAt?: Timestamp;
+
+  /**
+   * @generated from field: bool archived = 15;
+   */
+  archived: boolean;
+
+  /**
+   * @generated from field: myawesomelist.v1.Release latest_release = 16;
+   */
+  latestRelease?: Release;
+
+  /**
+   * Mean number of days between the most recent releases
+   *
+   * @generated from field: optional double release_cadence_days = 17;
+   */
+  releaseCadenceDays?: number;
+
+  /**
+   * Composite health score between 0 and 100, see internal/awesome/health
+   *
+   * @generated from field: optional double health_score = 18;
+   */
+  healthScore?: number;
+
+  /**
+   * @generated from field: optional uint32 contributors_count = 19;
+   */
+  contributorsCount?: number;
+
+  /**
+   * Share of commits authored by the top contributor, between 0 and 1
+   *
+   * @generated from field: optional double top_contributor_share = 20;
+   */
+  topContributorShare?: number;
+
+  /**
+   * Smallest number of contributors authoring half of the commits; 1 means a single maintainer
+   *
+   * @generated from field: optional uint32 bus_factor = 21;
+   */
+  busFactor?: number;
+
+  /**
+   * @generated from field: repeated myawesomelist.v1.RegistryStats registry_stats = 22;
+   */
+  registryStats: RegistryStats[];
 };
 
 /**
@@ -61,6 +165,141 @@ export const ProjectStatsSchema: GenMessage<ProjectStats> =
   /*@__PURE__*/
   messageDesc(file_myawesomelist_v1_myawesomelist, 0);
 
+/**
+ * RegistryStats holds the popularity of the package published from a repository on a registry
+ *
+ * @generated from message myawesomelist.v1.RegistryStats
+ */
+export type RegistryStats = Message<"myawesomelist.v1.RegistryStats"> & {
+  /**
+   * Registry identifier: npm, hex or goproxy
+   *
+   * @generated from field: string registry = 1;
+   */
+  registry: string;
+
+  /**
+   * @generated from field: string package = 2;
+   */
+  package: string;
+
+  /**
+   * Downloads over downloads_period, unset when the registry does not report downloads
+   *
+   * @generated from field: optional uint64 downloads = 3;
+   */
+  downloads?: bigint;
+
+  /**
+   * @generated from field: string downloads_period = 4;
+   */
+  downloadsPeriod: string;
+
+  /**
+   * @generated from field: string latest_version = 5;
+   */
+  latestVersion: string;
+
+  /**
+   * @generated from field: optional uint32 versions_count = 6;
+   */
+  versionsCount?: number;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp updated_at = 7;
+   */
+  updatedAt?: Timestamp;
+};
+
+/**
+ * Describes the message myawesomelist.v1.RegistryStats.
+ * Use `create(RegistryStatsSchema)` to create a new message.
+ */
+export const RegistryStatsSchema: GenMessage<RegistryStats> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 1);
+
+/**
+ * Release is a published release of a repository
+ *
+ * @generated from message myawesomelist.v1.Release
+ */
+export type Release = Message<"myawesomelist.v1.Release"> & {
+  /**
+   * @generated from field: string tag_name = 1;
+   */
+  tagName: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp published_at = 3;
+   */
+  publishedAt?: Timestamp;
+
+  /**
+   * @generated from field: bool prerelease = 4;
+   */
+  prerelease: boolean;
+
+  /**
+   * @generated from field: string url = 5;
+   */
+  url: string;
+};
+
+/**
+ * Describes the message myawesomelist.v1.Release.
+ * Use `create(ReleaseSchema)` to create a new message.
+ */
+export const ReleaseSchema: GenMessage<Release> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 2);
+
+/**
+ * ProjectStatsPoint is a snapshot of repository counters at a point in time
+ *
+ * @generated from message myawesomelist.v1.ProjectStatsPoint
+ */
+export type ProjectStatsPoint =
+  Message<"myawesomelist.v1.ProjectStatsPoint"> & {
+    /**
+     * @generated from field: google.protobuf.Timestamp recorded_at = 1;
+     */
+    recordedAt?: Timestamp;
+
+    /**
+     * @generated from field: optional uint32 stargazers_count = 2;
+     */
+    stargazersCount?: number;
+
+    /**
+     * @generated from field: optional uint32 open_issue_count = 3;
+     */
+    openIssueCount?: number;
+
+    /**
+     * @generated from field: optional uint32 forks_count = 4;
+     */
+    forksCount?: number;
+
+    /**
+     * @generated from field: optional uint32 subscribers_count = 5;
+     */
+    subscribersCount?: number;
+  };
+
+/**
+ * Describes the message myawesomelist.v1.ProjectStatsPoint.
+ * Use `create(ProjectStatsPointSchema)` to create a new message.
+ */
+export const ProjectStatsPointSchema: GenMessage<ProjectStatsPoint> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 3);
+
 /**
  * @generated from message myawesomelist.v1.Project
  */
@@ -89,6 +328,45 @@ export type Project = Message<"myawesomelist.v1.Project"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 5;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * @generated from field: myawesomelist.v1.RepositoryStatus status = 6;
+   */
+  status: RepositoryStatus;
+
+  /**
+   * @generated from field: myawesomelist.v1.Release latest_release = 7;
+   */
+  latestRelease?: Release;
+
+  /**
+   * Composite health score between 0 and 100, unset until scored
+   *
+   * @generated from field: optional double health_score = 8;
+   */
+  healthScore?: number;
+
+  /**
+   * Number of catalogued repositories depending on this project
+   *
+   * @generated from field: uint32 dependents_count = 9;
+   */
+  dependentsCount: number;
+
+  /**
+   * Set when the entry was dropped from its collection README
+   *
+   * @generated from field: google.protobuf.Timestamp removed_at = 10;
+   */
+  removedAt?: Timestamp;
+
+  /**
+   * When the entry first appeared in the collection README, set in collection listings.
+   * Falls back to the first ingest until the README history is backfilled.
+   *
+   * @generated from field: google.protobuf.Timestamp first_seen_at = 11;
+   */
+  firstSeenAt?: Timestamp;
 };
 
 /**
@@ -97,7 +375,114 @@ export type Project = Message<"myawesomelist.v1.Project"> & {
  */
 export const ProjectSchema: GenMessage<Project> =
   /*@__PURE__*/
-  messageDesc(file_myawesomelist_v1_myawesomelist, 1);
+  messageDesc(file_myawesomelist_v1_myawesomelist, 4);
+
+/**
+ * DependencyEdge links a catalogued repository to another one through a package dependency
+ *
+ * @generated from message myawesomelist.v1.DependencyEdge
+ */
+export type DependencyEdge = Message<"myawesomelist.v1.DependencyEdge"> & {
+  /**
+   * @generated from field: myawesomelist.v1.Repository repo = 1;
+   */
+  repo?: Repository;
+
+  /**
+   * Ecosystem of the manifest declaring the dependency: go, npm or hex
+   *
+   * @generated from field: string ecosystem = 2;
+   */
+  ecosystem: string;
+
+  /**
+   * Name of the package the dependency was declared on
+   *
+   * @generated from field: string package = 3;
+   */
+  package: string;
+};
+
+/**
+ * Describes the message myawesomelist.v1.DependencyEdge.
+ * Use `create(DependencyEdgeSchema)` to create a new message.
+ */
+export const DependencyEdgeSchema: GenMessage<DependencyEdge> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 5);
+
+/**
+ * TrendingProject is a project ranked by its star gain over a window
+ *
+ * @generated from message myawesomelist.v1.TrendingProject
+ */
+export type TrendingProject = Message<"myawesomelist.v1.TrendingProject"> & {
+  /**
+   * @generated from field: myawesomelist.v1.Project project = 1;
+   */
+  project?: Project;
+
+  /**
+   * @generated from field: optional uint32 stargazers_count = 2;
+   */
+  stargazersCount?: number;
+
+  /**
+   * Stars gained between the start of the window and the latest snapshot
+   *
+   * @generated from field: int32 stargazers_delta = 3;
+   */
+  stargazersDelta: number;
+
+  /**
+   * Star gain damped by the square root of the starting star count
+   *
+   * @generated from field: double score = 4;
+   */
+  score: number;
+};
+
+/**
+ * Describes the message myawesomelist.v1.TrendingProject.
+ * Use `create(TrendingProjectSchema)` to create a new message.
+ */
+export const TrendingProjectSchema: GenMessage<TrendingProject> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 6);
+
+/**
+ * SimilarProject is a project ranked by the distance of its embedding to another project
+ *
+ * @generated from message myawesomelist.v1.SimilarProject
+ */
+export type SimilarProject = Message<"myawesomelist.v1.SimilarProject"> & {
+  /**
+   * @generated from field: myawesomelist.v1.Project project = 1;
+   */
+  project?: Project;
+
+  /**
+   * Distance between the embeddings; lower is more similar
+   *
+   * @generated from field: double distance = 2;
+   */
+  distance: number;
+
+  /**
+   * Language of the collection the project is listed in
+   *
+   * @generated from field: string language = 3;
+   */
+  language: string;
+};
+
+/**
+ * Describes the message myawesomelist.v1.SimilarProject.
+ * Use `create(SimilarProjectSchema)` to create a new message.
+ */
+export const SimilarProjectSchema: GenMessage<SimilarProject> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 7);
 
 /**
  * Category groups projects under a section
@@ -124,6 +509,13 @@ export type Category = Message<"myawesomelist.v1.Category"> & {
    * @generated from field: google.protobuf.Timestamp updated_at = 4;
    */
   updatedAt?: Timestamp;
+
+  /**
+   * Set when the section was dropped from its collection README
+   *
+   * @generated from field: google.protobuf.Timestamp removed_at = 5;
+   */
+  removedAt?: Timestamp;
 };
 
 /**
@@ -132,7 +524,7 @@ export type Category = Message<"myawesomelist.v1.Category"> & {
  */
 export const CategorySchema: GenMessage<Category> =
   /*@__PURE__*/
-  messageDesc(file_myawesomelist_v1_myawesomelist, 2);
+  messageDesc(file_myawesomelist_v1_myawesomelist, 8);
 
 /**
  * Collection represents an awesome repository parsed into categories
@@ -172,119 +564,819 @@ export type Collection = Message<"myawesomelist.v1.Collection"> & {
  */
 export const CollectionSchema: GenMessage<Collection> =
   /*@__PURE__*/
-  messageDesc(file_myawesomelist_v1_myawesomelist, 3);
+  messageDesc(file_myawesomelist_v1_myawesomelist, 9);
 
 /**
- * Identify a source awesome repository (owner/repo)
+ * CollectionEvent records a change to a collection entry detected between two ingests
  *
- * @generated from message myawesomelist.v1.Repository
+ * @generated from message myawesomelist.v1.CollectionEvent
  */
-export type Repository = Message<"myawesomelist.v1.Repository"> & {
+export type CollectionEvent = Message<"myawesomelist.v1.CollectionEvent"> & {
   /**
-   * @generated from field: string hostname = 1;
+   * @generated from field: uint64 id = 1;
    */
-  hostname: string;
+  id: bigint;
 
   /**
-   * @generated from field: string owner = 2;
+   * @generated from field: myawesomelist.v1.CollectionEventType type = 2;
    */
-  owner: string;
+  type: CollectionEventType;
 
   /**
-   * @generated from field: string repo = 3;
+   * Repository of the collection the entry is listed in
+   *
+   * @generated from field: myawesomelist.v1.Repository collection_repo = 3;
    */
-  repo: string;
-};
+  collectionRepo?: Repository;
 
-/**
- * Describes the message myawesomelist.v1.Repository.
- * Use `create(RepositorySchema)` to create a new message.
- */
-export const RepositorySchema: GenMessage<Repository> =
-  /*@__PURE__*/
-  messageDesc(file_myawesomelist_v1_myawesomelist, 4);
+  /**
+   * Repository of the listed project
+   *
+   * @generated from field: myawesomelist.v1.Repository project_repo = 4;
+   */
+  projectRepo?: Repository;
 
-/**
- * @generated from message myawesomelist.v1.ListCollectionsRequest
+  /**
+   * @generated from field: string project_name = 5;
+   */
+  projectName: string;
+
+  /**
+   * @generated from field: string category_name = 6;
+   */
+  categoryName: string;
+
+  /**
+   * Category the entry was listed under before a move
+   *
+   * @generated from field: string previous_category_name = 7;
+   */
+  previousCategoryName: string;
+
+  /**
+   * @generated from field: string description = 8;
+   */
+  description: string;
+
+  /**
+   * Description of the entry before an edit
+   *
+   * @generated from field: string previous_description = 9;
+   */
+  previousDescription: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 10;
+   */
+  createdAt?: Timestamp;
+};
+
+/**
+ * Describes the message myawesomelist.v1.CollectionEvent.
+ * Use `create(CollectionEventSchema)` to create a new message.
+ */
+export const CollectionEventSchema: GenMessage<CollectionEvent> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 10);
+
+/**
+ * Identify a source awesome repository (owner/repo)
+ *
+ * @generated from message myawesomelist.v1.Repository
+ */
+export type Repository = Message<"myawesomelist.v1.Repository"> & {
+  /**
+   * @generated from field: string hostname = 1;
+   */
+  hostname: string;
+
+  /**
+   * @generated from field: string owner = 2;
+   */
+  owner: string;
+
+  /**
+   * @generated from field: string repo = 3;
+   */
+  repo: string;
+};
+
+/**
+ * Describes the message myawesomelist.v1.Repository.
+ * Use `create(RepositorySchema)` to create a new message.
+ */
+export const RepositorySchema: GenMessage<Repository> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 11);
+
+/**
+ * @generated from message myawesomelist.v1.ListCollectionsRequest
+ */
+export type ListCollectionsRequest =
+  Message<"myawesomelist.v1.ListCollectionsRequest"> & {
+    /**
+     * @generated from field: repeated myawesomelist.v1.Repository repos = 1;
+     */
+    repos: Repository[];
+
+    /**
+     * Maximum number of collections to return; the server picks a default when unset
+     *
+     * @generated from field: uint32 page_size = 2;
+     */
+    pageSize: number;
+
+    /**
+     * next_page_token of the previous page, or empty for the first page
+     *
+     * @generated from field: string page_token = 3;
+     */
+    pageToken: string;
+  };
+
+/**
+ * Describes the message myawesomelist.v1.ListCollectionsRequest.
+ * Use `create(ListCollectionsRequestSchema)` to create a new message.
+ */
+export const ListCollectionsRequestSchema: GenMessage<ListCollectionsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 12);
+
+/**
+ * @generated from message myawesomelist.v1.ListCollectionsResponse
+ */
+export type ListCollectionsResponse =
+  Message<"myawesomelist.v1.ListCollectionsResponse"> & {
+    /**
+     * @generated from field: repeated myawesomelist.v1.Collection collections = 1;
+     */
+    collections: Collection[];
+
+    /**
+     * Token of the next page, or empty on the last page
+     *
+     * @generated from field: string next_page_token = 2;
+     */
+    nextPageToken: string;
+
+    /**
+     * Number of collections across all pages
+     *
+     * @generated from field: uint32 total_size = 3;
+     */
+    totalSize: number;
+  };
+
+/**
+ * Describes the message myawesomelist.v1.ListCollectionsResponse.
+ * Use `create(ListCollectionsResponseSchema)` to create a new message.
+ */
+export const ListCollectionsResponseSchema: GenMessage<ListCollectionsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 13);
+
+/**
+ * @generated from message myawesomelist.v1.GetCollectionRequest
+ */
+export type GetCollectionRequest =
+  Message<"myawesomelist.v1.GetCollectionRequest"> & {
+    /**
+     * @generated from field: myawesomelist.v1.Repository repo = 1;
+     */
+    repo?: Repository;
+
+    /**
+     * Also return categories and projects dropped from the README
+     *
+     * @generated from field: bool include_removed = 2;
+     */
+    includeRemoved: boolean;
+  };
+
+/**
+ * Describes the message myawesomelist.v1.GetCollectionRequest.
+ * Use `create(GetCollectionRequestSchema)` to create a new message.
+ */
+export const GetCollectionRequestSchema: GenMessage<GetCollectionRequest> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 14);
+
+/**
+ * @generated from message myawesomelist.v1.GetCollectionResponse
+ */
+export type GetCollectionResponse =
+  Message<"myawesomelist.v1.GetCollectionResponse"> & {
+    /**
+     * @generated from field: myawesomelist.v1.Collection collection = 1;
+     */
+    collection?: Collection;
+  };
+
+/**
+ * Describes the message myawesomelist.v1.GetCollectionResponse.
+ * Use `create(GetCollectionResponseSchema)` to create a new message.
+ */
+export const GetCollectionResponseSchema: GenMessage<GetCollectionResponse> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 15);
+
+/**
+ * @generated from message myawesomelist.v1.ListCategoriesRequest
+ */
+export type ListCategoriesRequest =
+  Message<"myawesomelist.v1.ListCategoriesRequest"> & {
+    /**
+     * @generated from field: myawesomelist.v1.Repository repo = 1;
+     */
+    repo?: Repository;
+
+    /**
+     * Maximum number of categories to return; the server picks a default when unset
+     *
+     * @generated from field: uint32 page_size = 2;
+     */
+    pageSize: number;
+
+    /**
+     * next_page_token of the previous page, or empty for the first page
+     *
+     * @generated from field: string page_token = 3;
+     */
+    pageToken: string;
+  };
+
+/**
+ * Describes the message myawesomelist.v1.ListCategoriesRequest.
+ * Use `create(ListCategoriesRequestSchema)` to create a new message.
+ */
+export const ListCategoriesRequestSchema: GenMessage<ListCategoriesRequest> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 16);
+
+/**
+ * @generated from message myawesomelist.v1.ListCategoriesResponse
+ */
+export type ListCategoriesResponse =
+  Message<"myawesomelist.v1.ListCategoriesResponse"> & {
+    /**
+     * @generated from field: repeated myawesomelist.v1.Category categories = 1;
+     */
+    categories: Category[];
+
+    /**
+     * Token of the next page, or empty on the last page
+     *
+     * @generated from field: string next_page_token = 2;
+     */
+    nextPageToken: string;
+
+    /**
+     * Number of categories across all pages
+     *
+     * @generated from field: uint32 total_size = 3;
+     */
+    totalSize: number;
+  };
+
+/**
+ * Describes the message myawesomelist.v1.ListCategoriesResponse.
+ * Use `create(ListCategoriesResponseSchema)` to create a new message.
+ */
+export const ListCategoriesResponseSchema: GenMessage<ListCategoriesResponse> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 17);
+
+/**
+ * @generated from message myawesomelist.v1.ListProjectsRequest
+ */
+export type ListProjectsRequest =
+  Message<"myawesomelist.v1.ListProjectsRequest"> & {
+    /**
+     * @generated from field: myawesomelist.v1.Repository repo = 1;
+     */
+    repo?: Repository;
+
+    /**
+     * @generated from field: string category_name = 2;
+     */
+    categoryName: string;
+
+    /**
+     * @generated from field: myawesomelist.v1.ProjectOrderBy order_by = 3;
+     */
+    orderBy: ProjectOrderBy;
+
+    /**
+     * Maximum number of projects to return; the server picks a default when unset
+     *
+     * @generated from field: uint32 page_size = 4;
+     */
+    pageSize: number;
+
+    /**
+     * next_page_token of the previous page, or empty for the first page
+     *
+     * @generated from field: string page_token = 5;
+     */
+    pageToken: string;
+  };
+
+/**
+ * Describes the message myawesomelist.v1.ListProjectsRequest.
+ * Use `create(ListProjectsRequestSchema)` to create a new message.
+ */
+export const ListProjectsRequestSchema: GenMessage<ListProjectsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 18);
+
+/**
+ * @generated from message myawesomelist.v1.ListProjectsResponse
+ */
+export type ListProjectsResponse =
+  Message<"myawesomelist.v1.ListProjectsResponse"> & {
+    /**
+     * @generated from field: repeated myawesomelist.v1.Project projects = 1;
+     */
+    projects: Project[];
+
+    /**
+     * Token of the next page, or empty on the last page
+     *
+     * @generated from field: string next_page_token = 2;
+     */
+    nextPageToken: string;
+
+    /**
+     * Number of projects across all pages
+     *
+     * @generated from field: uint32 total_size = 3;
+     */
+    totalSize: number;
+  };
+
+/**
+ * Describes the message myawesomelist.v1.ListProjectsResponse.
+ * Use `create(ListProjectsResponseSchema)` to create a new message.
+ */
+export const ListProjectsResponseSchema: GenMessage<ListProjectsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 19);
+
+/**
+ * @generated from message myawesomelist.v1.SearchProjectsRequest
+ */
+export type SearchProjectsRequest =
+  Message<"myawesomelist.v1.SearchProjectsRequest"> & {
+    /**
+     * @generated from field: string query = 1;
+     */
+    query: string;
+
+    /**
+     * Deprecated: use page_size
+     *
+     * @generated from field: uint32 limit = 2;
+     */
+    limit: number;
+
+    /**
+     * @generated from field: repeated myawesomelist.v1.Repository repos = 3;
+     */
+    repos: Repository[];
+
+    /**
+     * Also match projects dropped from their collection README
+     *
+     * @generated from field: bool include_removed = 4;
+     */
+    includeRemoved: boolean;
+
+    /**
+     * Weight of the embedding similarity ranking in the fused ranking, 1 when unset, 0 disables it
+     *
+     * @generated from field: optional double semantic_weight = 5;
+     */
+    semanticWeight?: number;
+
+    /**
+     * Weight of the full-text ranking over name and description in the fused ranking, 1 when unset, 0 disables it
+     *
+     * @generated from field: optional double lexical_weight = 6;
+     */
+    lexicalWeight?: number;
+
+    /**
+     * Only match projects of collections in this language, case-insensitive
+     *
+     * @generated from field: string language = 7;
+     */
+    language: string;
+
+    /**
+     * Only match projects listed under this category name, case-insensitive
+     *
+     * @generated from field: string category = 8;
+     */
+    category: string;
+
+    /**
+     * Only match projects with at least this many stars
+     *
+     * @generated from field: optional uint32 min_stars = 9;
+     */
+    minStars?: number;
+
+    /**
+     * Only match projects with at most this many stars
+     *
+     * @generated from field: optional uint32 max_stars = 10;
+     */
+    maxStars?: number;
+
+    /**
+     * Only match projects pushed to after this time
+     *
+     * @generated from field: google.protobuf.Timestamp pushed_after = 11;
+     */
+    pushedAfter?: Timestamp;
+
+    /**
+     * Only match projects under this license SPDX identifier, case-insensitive
+     *
+     * @generated from field: string license = 12;
+     */
+    license: string;
+
+    /**
+     * Only match projects hosted on this hostname
+     *
+     * @generated from field: string host = 13;
+     */
+    host: string;
+
+    /**
+     * Skip archived repositories
+     *
+     * @generated from field: bool exclude_archived = 14;
+     */
+    excludeArchived: boolean;
+
+    /**
+     * Maximum number of projects to return; limit or a server default when unset
+     *
+     * @generated from field: uint32 page_size = 15;
+     */
+    pageSize: number;
+
+    /**
+     * next_page_token of the previous page, or empty for the first page
+     *
+     * @generated from field: string page_token = 16;
+     */
+    pageToken: string;
+  };
+
+/**
+ * Describes the message myawesomelist.v1.SearchProjectsRequest.
+ * Use `create(SearchProjectsRequestSchema)` to create a new message.
+ */
+export const SearchProjectsRequestSchema: GenMessage<SearchProjectsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 20);
+
+/**
+ * @generated from message myawesomelist.v1.SearchProjectsResponse
+ */
+export type SearchProjectsResponse =
+  Message<"myawesomelist.v1.SearchProjectsResponse"> & {
+    /**
+     * Projects ranked by fusing the 100 best matches of the embedding ranking with the 100 best
+     * matches of the full-text ranking, so a search returns at most 200 projects
+     *
+     * @generated from field: repeated myawesomelist.v1.Project projects = 1;
+     */
+    projects: Project[];
+
+    /**
+     * Token of the next page, or empty on the last page of the ranked window
+     *
+     * @generated from field: string next_page_token = 2;
+     */
+    nextPageToken: string;
+
+    /**
+     * Number of projects across all pages, which is the size of the ranked window rather than the number of matching projects
+     *
+     * @generated from field: uint32 total_size = 3;
+     */
+    totalSize: number;
+  };
+
+/**
+ * Describes the message myawesomelist.v1.SearchProjectsResponse.
+ * Use `create(SearchProjectsResponseSchema)` to create a new message.
+ */
+export const SearchProjectsResponseSchema: GenMessage<SearchProjectsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 21);
+
+/**
+ * @generated from message myawesomelist.v1.GetProjectStatsRequest
+ */
+export type GetProjectStatsRequest =
+  Message<"myawesomelist.v1.GetProjectStatsRequest"> & {
+    /**
+     * @generated from field: myawesomelist.v1.Repository repo = 1;
+     */
+    repo?: Repository;
+  };
+
+/**
+ * Describes the message myawesomelist.v1.GetProjectStatsRequest.
+ * Use `create(GetProjectStatsRequestSchema)` to create a new message.
+ */
+export const GetProjectStatsRequestSchema: GenMessage<GetProjectStatsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 22);
+
+/**
+ * @generated from message myawesomelist.v1.GetProjectStatsResponse
+ */
+export type GetProjectStatsResponse =
+  Message<"myawesomelist.v1.GetProjectStatsResponse"> & {
+    /**
+     * @generated from field: myawesomelist.v1.ProjectStats stats = 1;
+     */
+    stats?: ProjectStats;
+  };
+
+/**
+ * Describes the message myawesomelist.v1.GetProjectStatsResponse.
+ * Use `create(GetProjectStatsResponseSchema)` to create a new message.
+ */
+export const GetProjectStatsResponseSchema: GenMessage<GetProjectStatsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 23);
+
+/**
+ * @generated from message myawesomelist.v1.GetProjectStatsHistoryRequest
+ */
+export type GetProjectStatsHistoryRequest =
+  Message<"myawesomelist.v1.GetProjectStatsHistoryRequest"> & {
+    /**
+     * @generated from field: myawesomelist.v1.Repository repo = 1;
+     */
+    repo?: Repository;
+
+    /**
+     * Inclusive lower bound; unset means since the first recorded point
+     *
+     * @generated from field: google.protobuf.Timestamp start_time = 2;
+     */
+    startTime?: Timestamp;
+
+    /**
+     * Exclusive upper bound; unset means up to now
+     *
+     * @generated from field: google.protobuf.Timestamp end_time = 3;
+     */
+    endTime?: Timestamp;
+
+    /**
+     * Keep the last point of each bucket; unspecified returns every point
+     *
+     * @generated from field: myawesomelist.v1.StatsInterval interval = 4;
+     */
+    interval: StatsInterval;
+  };
+
+/**
+ * Describes the message myawesomelist.v1.GetProjectStatsHistoryRequest.
+ * Use `create(GetProjectStatsHistoryRequestSchema)` to create a new message.
+ */
+export const GetProjectStatsHistoryRequestSchema: GenMessage<GetProjectStatsHistoryRequest> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 24);
+
+/**
+ * @generated from message myawesomelist.v1.GetProjectStatsHistoryResponse
+ */
+export type GetProjectStatsHistoryResponse =
+  Message<"myawesomelist.v1.GetProjectStatsHistoryResponse"> & {
+    /**
+     * @generated from field: repeated myawesomelist.v1.ProjectStatsPoint points = 1;
+     */
+    points: ProjectStatsPoint[];
+  };
+
+/**
+ * Describes the message myawesomelist.v1.GetProjectStatsHistoryResponse.
+ * Use `create(GetProjectStatsHistoryResponseSchema)` to create a new message.
+ */
+export const GetProjectStatsHistoryResponseSchema: GenMessage<GetProjectStatsHistoryResponse> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 25);
+
+/**
+ * @generated from message myawesomelist.v1.ListTrendingProjectsRequest
+ */
+export type ListTrendingProjectsRequest =
+  Message<"myawesomelist.v1.ListTrendingProjectsRequest"> & {
+    /**
+     * Defaults to a week when unspecified
+     *
+     * @generated from field: myawesomelist.v1.TrendingWindow window = 1;
+     */
+    window: TrendingWindow;
+
+    /**
+     * Restrict to projects listed in this collection
+     *
+     * @generated from field: myawesomelist.v1.Repository repo = 2;
+     */
+    repo?: Repository;
+
+    /**
+     * Restrict to projects listed under this category name
+     *
+     * @generated from field: string category_name = 3;
+     */
+    categoryName: string;
+
+    /**
+     * Restrict to collections of this language (case-insensitive)
+     *
+     * @generated from field: string language = 4;
+     */
+    language: string;
+
+    /**
+     * @generated from field: uint32 limit = 5;
+     */
+    limit: number;
+  };
+
+/**
+ * Describes the message myawesomelist.v1.ListTrendingProjectsRequest.
+ * Use `create(ListTrendingProjectsRequestSchema)` to create a new message.
+ */
+export const ListTrendingProjectsRequestSchema: GenMessage<ListTrendingProjectsRequest> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 26);
+
+/**
+ * @generated from message myawesomelist.v1.ListTrendingProjectsResponse
+ */
+export type ListTrendingProjectsResponse =
+  Message<"myawesomelist.v1.ListTrendingProjectsResponse"> & {
+    /**
+     * @generated from field: repeated myawesomelist.v1.TrendingProject projects = 1;
+     */
+    projects: TrendingProject[];
+  };
+
+/**
+ * Describes the message myawesomelist.v1.ListTrendingProjectsResponse.
+ * Use `create(ListTrendingProjectsResponseSchema)` to create a new message.
+ */
+export const ListTrendingProjectsResponseSchema: GenMessage<ListTrendingProjectsResponse> =
+  /*@__PURE__*/
+  messageDesc(file_myawesomelist_v1_myawesomelist, 27);
+
+/**
+ * @generated from message myawesomelist.v1.ListCollectionChangesRequest
  */
-export type ListCollectionsRequest =
-  Message<"myawesomelist.v1.ListCollectionsRequest"> & {
+export type ListCollectionChangesRequest =
+  Message<"myawesomelist.v1.ListCollectionChangesRequest"> & {
     /**
-     * @generated from field: repeated myawesomelist.v1.Repository repos = 1;
+     * Restrict to changes of this collection
+     *
+     * @generated from field: myawesomelist.v1.Repository repo = 1;
      */
-    repos: Repository[];
+    repo?: Repository;
+
+    /**
+     * Inclusive lower bound; unset means since the first recorded change
+     *
+     * @generated from field: google.protobuf.Timestamp start_time = 2;
+     */
+    startTime?: Timestamp;
+
+    /**
+     * Exclusive upper bound; unset means up to now
+     *
+     * @generated from field: google.protobuf.Timestamp end_time = 3;
+     */
+    endTime?: Timestamp;
+
+    /**
+     * @generated from field: uint32 limit = 4;
+     */
+    limit: number;
   };
 
 /**
- * Describes the message myawesomelist.v1.ListCollectionsRequest.
- * Use `create(ListCollectionsRequestSchema)` to create a new message.
+ * Describes the message myawesomelist.v1.ListCollectionChangesRequest.
+ * Use `create(ListCollectionChangesRequestSchema)` to create a new message.
  */
-export const ListCollectionsRequestSchema: GenMessage<ListCollectionsRequest> =
+export const ListCollectionChangesRequestSchema: GenMessage<ListCollectionChangesRequest> =
   /*@__PURE__*/
-  messageDesc(file_myawesomelist_v1_myawesomelist, 5);
+  messageDesc(file_myawesomelist_v1_myawesomelist, 28);
 
 /**
- * @generated from message myawesomelist.v1.ListCollectionsResponse
+ * @generated from message myawesomelist.v1.ListCollectionChangesResponse
  */
-export type ListCollectionsResponse =
-  Message<"myawesomelist.v1.ListCollectionsResponse"> & {
+export type ListCollectionChangesResponse =
+  Message<"myawesomelist.v1.ListCollectionChangesResponse"> & {
     /**
-     * @generated from field: repeated myawesomelist.v1.Collection collections = 1;
+     * Most recent changes first
+     *
+     * @generated from field: repeated myawesomelist.v1.CollectionEvent events = 1;
      */
-    collections: Collection[];
+    events: CollectionEvent[];
   };
 
 /**
- * Describes the message myawesomelist.v1.ListCollectionsResponse.
- * Use `create(ListCollectionsResponseSchema)` to create a new message.
+ * Describes the message myawesomelist.v1.ListCollectionChangesResponse.
+ * Use `create(ListCollectionChangesResponseSchema)` to create a new message.
  */
-export const ListCollectionsResponseSchema: GenMessage<ListCollectionsResponse> =
+export const ListCollectionChangesResponseSchema: GenMessage<ListCollectionChangesResponse> =
   /*@__PURE__*/
-  messageDesc(file_myawesomelist_v1_myawesomelist, 6);
+  messageDesc(file_myawesomelist_v1_myawesomelist, 29);
 
 /**
- * @generated from message myawesomelist.v1.GetCollectionRequest
+ * @generated from message myawesomelist.v1.FindSimilarProjectsRequest
  */
-export type GetCollectionRequest =
-  Message<"myawesomelist.v1.GetCollectionRequest"> & {
+export type FindSimilarProjectsRequest =
+  Message<"myawesomelist.v1.FindSimilarProjectsRequest"> & {
     /**
-     * @generated from field: myawesomelist.v1.Repository repo = 1;
+     * Project to find neighbours of; takes precedence over repo
+     *
+     * @generated from field: uint64 project_id = 1;
+     */
+    projectId: bigint;
+
+    /**
+     * Repository to find neighbours of, averaging its embeddings across the collections listing it
+     *
+     * @generated from field: myawesomelist.v1.Repository repo = 2;
      */
     repo?: Repository;
+
+    /**
+     * Only return projects listed in other collections than the source project
+     *
+     * @generated from field: bool other_collections_only = 3;
+     */
+    otherCollectionsOnly: boolean;
+
+    /**
+     * Only return projects of collections in these languages (case-insensitive)
+     *
+     * @generated from field: repeated string languages = 4;
+     */
+    languages: string[];
+
+    /**
+     * @generated from field: uint32 limit = 5;
+     */
+    limit: number;
   };
 
 /**
- * Describes the message myawesomelist.v1.GetCollectionRequest.
- * Use `create(GetCollectionRequestSchema)` to create a new message.
+ * Describes the message myawesomelist.v1.FindSimilarProjectsRequest.
+ * Use `create(FindSimilarProjectsRequestSchema)` to create a new message.
  */
-export const GetCollectionRequestSchema: GenMessage<GetCollectionRequest> =
+export const FindSimilarProjectsRequestSchema: GenMessage<FindSimilarProjectsRequest> =
   /*@__PURE__*/
-  messageDesc(file_myawesomelist_v1_myawesomelist, 7);
+  messageDesc(file_myawesomelist_v1_myawesomelist, 30);
 
 /**
- * @generated from message myawesomelist.v1.GetCollectionResponse
+ * @generated from message myawesomelist.v1.FindSimilarProjectsResponse
  */
-export type GetCollectionResponse =
-  Message<"myawesomelist.v1.GetCollectionResponse"> & {
+export type FindSimilarProjectsResponse =
+  Message<"myawesomelist.v1.FindSimilarProjectsResponse"> & {
     /**
-     * @generated from field: myawesomelist.v1.Collection collection = 1;
+     * Nearest projects first, one per repository
+     *
+     * @generated from field: repeated myawesomelist.v1.SimilarProject projects = 1;
      */
-    collection?: Collection;
+    projects: SimilarProject[];
   };
 
 /**
- * Describes the message myawesomelist.v1.GetCollectionResponse.
- * Use `create(GetCollectionResponseSchema)` to create a new message.
+ * Describes the message myawesomelist.v1.FindSimilarProjectsResponse.
+ * Use `create(FindSimilarProjectsResponseSchema)` to create a new message.
  */
-export const GetCollectionResponseSchema: GenMessage<GetCollectionResponse> =
+export const FindSimilarProjectsResponseSchema: GenMessage<FindSimilarProjectsResponse> =
   /*@__PURE__*/
-  messageDesc(file_myawesomelist_v1_myawesomelist, 8);
+  messageDesc(file_myawesomelist_v1_myawesomelist, 31);
 
 /**
- * @generated from message myawesomelist.v1.ListCategoriesRequest
+ * @generated from message myawesomelist.v1.ListDependenciesRequest
  */
-export type ListCategoriesRequest =
-  Message<"myawesomelist.v1.ListCategoriesRequest"> & {
+export type ListDependenciesRequest =
+  Message<"myawesomelist.v1.ListDependenciesRequest"> & {
     /**
      * @generated from field: myawesomelist.v1.Repository repo = 1;
      */
@@ -292,160 +1384,272 @@ export type ListCategoriesRequest =
   };
 
 /**
- * Describes the message myawesomelist.v1.ListCategoriesRequest.
- * Use `create(ListCategoriesRequestSchema)` to create a new message.
+ * Describes the message myawesomelist.v1.ListDependenciesRequest.
+ * Use `create(ListDependenciesRequestSchema)` to create a new message.
  */
-export const ListCategoriesRequestSchema: GenMessage<ListCategoriesRequest> =
+export const ListDependenciesRequestSchema: GenMessage<ListDependenciesRequest> =
   /*@__PURE__*/
-  messageDesc(file_myawesomelist_v1_myawesomelist, 9);
+  messageDesc(file_myawesomelist_v1_myawesomelist, 32);
 
 /**
- * @generated from message myawesomelist.v1.ListCategoriesResponse
+ * @generated from message myawesomelist.v1.ListDependenciesResponse
  */
-export type ListCategoriesResponse =
-  Message<"myawesomelist.v1.ListCategoriesResponse"> & {
+export type ListDependenciesResponse =
+  Message<"myawesomelist.v1.ListDependenciesResponse"> & {
     /**
-     * @generated from field: repeated myawesomelist.v1.Category categories = 1;
+     * @generated from field: repeated myawesomelist.v1.DependencyEdge dependencies = 1;
      */
-    categories: Category[];
+    dependencies: DependencyEdge[];
   };
 
 /**
- * Describes the message myawesomelist.v1.ListCategoriesResponse.
- * Use `create(ListCategoriesResponseSchema)` to create a new message.
+ * Describes the message myawesomelist.v1.ListDependenciesResponse.
+ * Use `create(ListDependenciesResponseSchema)` to create a new message.
  */
-export const ListCategoriesResponseSchema: GenMessage<ListCategoriesResponse> =
+export const ListDependenciesResponseSchema: GenMessage<ListDependenciesResponse> =
   /*@__PURE__*/
-  messageDesc(file_myawesomelist_v1_myawesomelist, 10);
+  messageDesc(file_myawesomelist_v1_myawesomelist, 33);
 
 /**
- * @generated from message myawesomelist.v1.ListProjectsRequest
+ * @generated from message myawesomelist.v1.ListDependentsRequest
  */
-export type ListProjectsRequest =
-  Message<"myawesomelist.v1.ListProjectsRequest"> & {
+export type ListDependentsRequest =
+  Message<"myawesomelist.v1.ListDependentsRequest"> & {
     /**
      * @generated from field: myawesomelist.v1.Repository repo = 1;
      */
     repo?: Repository;
-
-    /**
-     * @generated from field: string category_name = 2;
-     */
-    categoryName: string;
   };
 
 /**
- * Describes the message myawesomelist.v1.ListProjectsRequest.
- * Use `create(ListProjectsRequestSchema)` to create a new message.
+ * Describes the message myawesomelist.v1.ListDependentsRequest.
+ * Use `create(ListDependentsRequestSchema)` to create a new message.
  */
-export const ListProjectsRequestSchema: GenMessage<ListProjectsRequest> =
+export const ListDependentsRequestSchema: GenMessage<ListDependentsRequest> =
   /*@__PURE__*/
-  messageDesc(file_myawesomelist_v1_myawesomelist, 11);
+  messageDesc(file_myawesomelist_v1_myawesomelist, 34);
 
 /**
- * @generated from message myawesomelist.v1.ListProjectsResponse
+ * @generated from message myawesomelist.v1.ListDependentsResponse
  */
-export type ListProjectsResponse =
-  Message<"myawesomelist.v1.ListProjectsResponse"> & {
+export type ListDependentsResponse =
+  Message<"myawesomelist.v1.ListDependentsResponse"> & {
     /**
-     * @generated from field: repeated myawesomelist.v1.Project projects = 1;
+     * @generated from field: repeated myawesomelist.v1.DependencyEdge dependents = 1;
      */
-    projects: Project[];
+    dependents: DependencyEdge[];
   };
 
 /**
- * Describes the message myawesomelist.v1.ListProjectsResponse.
- * Use `create(ListProjectsResponseSchema)` to create a new message.
+ * Describes the message myawesomelist.v1.ListDependentsResponse.
+ * Use `create(ListDependentsResponseSchema)` to create a new message.
  */
-export const ListProjectsResponseSchema: GenMessage<ListProjectsResponse> =
+export const ListDependentsResponseSchema: GenMessage<ListDependentsResponse> =
   /*@__PURE__*/
-  messageDesc(file_myawesomelist_v1_myawesomelist, 12);
+  messageDesc(file_myawesomelist_v1_myawesomelist, 35);
 
 /**
- * @generated from message myawesomelist.v1.SearchProjectsRequest
+ * RepositoryStatus reports the availability of a repository on its host
+ *
+ * @generated from enum myawesomelist.v1.RepositoryStatus
  */
-export type SearchProjectsRequest =
-  Message<"myawesomelist.v1.SearchProjectsRequest"> & {
-    /**
-     * @generated from field: string query = 1;
-     */
-    query: string;
+export enum RepositoryStatus {
+  /**
+   * @generated from enum value: REPOSITORY_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
 
-    /**
-     * @generated from field: uint32 limit = 2;
-     */
-    limit: number;
+  /**
+   * @generated from enum value: REPOSITORY_STATUS_ACTIVE = 1;
+   */
+  ACTIVE = 1,
 
-    /**
-     * @generated from field: repeated myawesomelist.v1.Repository repos = 3;
-     */
-    repos: Repository[];
-  };
+  /**
+   * @generated from enum value: REPOSITORY_STATUS_ARCHIVED = 2;
+   */
+  ARCHIVED = 2,
+
+  /**
+   * @generated from enum value: REPOSITORY_STATUS_DISABLED = 3;
+   */
+  DISABLED = 3,
+
+  /**
+   * @generated from enum value: REPOSITORY_STATUS_NOT_FOUND = 4;
+   */
+  NOT_FOUND = 4,
+
+  /**
+   * @generated from enum value: REPOSITORY_STATUS_BLOCKED = 5;
+   */
+  BLOCKED = 5,
+}
 
 /**
- * Describes the message myawesomelist.v1.SearchProjectsRequest.
- * Use `create(SearchProjectsRequestSchema)` to create a new message.
+ * Describes the enum myawesomelist.v1.RepositoryStatus.
  */
-export const SearchProjectsRequestSchema: GenMessage<SearchProjectsRequest> =
+export const RepositoryStatusSchema: GenEnum<RepositoryStatus> =
   /*@__PURE__*/
-  messageDesc(file_myawesomelist_v1_myawesomelist, 13);
+  enumDesc(file_myawesomelist_v1_myawesomelist, 0);
 
 /**
- * @generated from message myawesomelist.v1.SearchProjectsResponse
+ * StatsInterval selects the bucket size used to downsample a stats time series
+ *
+ * @generated from enum myawesomelist.v1.StatsInterval
  */
-export type SearchProjectsResponse =
-  Message<"myawesomelist.v1.SearchProjectsResponse"> & {
-    /**
-     * @generated from field: repeated myawesomelist.v1.Project projects = 1;
-     */
-    projects: Project[];
-  };
+export enum StatsInterval {
+  /**
+   * @generated from enum value: STATS_INTERVAL_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: STATS_INTERVAL_HOUR = 1;
+   */
+  HOUR = 1,
+
+  /**
+   * @generated from enum value: STATS_INTERVAL_DAY = 2;
+   */
+  DAY = 2,
+
+  /**
+   * @generated from enum value: STATS_INTERVAL_WEEK = 3;
+   */
+  WEEK = 3,
+
+  /**
+   * @generated from enum value: STATS_INTERVAL_MONTH = 4;
+   */
+  MONTH = 4,
+}
 
 /**
- * Describes the message myawesomelist.v1.SearchProjectsResponse.
- * Use `create(SearchProjectsResponseSchema)` to create a new message.
+ * Describes the enum myawesomelist.v1.StatsInterval.
  */
-export const SearchProjectsResponseSchema: GenMessage<SearchProjectsResponse> =
+export const StatsIntervalSchema: GenEnum<StatsInterval> =
   /*@__PURE__*/
-  messageDesc(file_myawesomelist_v1_myawesomelist, 14);
+  enumDesc(file_myawesomelist_v1_myawesomelist, 1);
 
 /**
- * @generated from message myawesomelist.v1.GetProjectStatsRequest
+ * TrendingWindow selects the period over which star gains are measured
+ *
+ * @generated from enum myawesomelist.v1.TrendingWindow
  */
-export type GetProjectStatsRequest =
-  Message<"myawesomelist.v1.GetProjectStatsRequest"> & {
-    /**
-     * @generated from field: myawesomelist.v1.Repository repo = 1;
-     */
-    repo?: Repository;
-  };
+export enum TrendingWindow {
+  /**
+   * @generated from enum value: TRENDING_WINDOW_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: TRENDING_WINDOW_DAY = 1;
+   */
+  DAY = 1,
+
+  /**
+   * @generated from enum value: TRENDING_WINDOW_WEEK = 2;
+   */
+  WEEK = 2,
+
+  /**
+   * @generated from enum value: TRENDING_WINDOW_MONTH = 3;
+   */
+  MONTH = 3,
+}
 
 /**
- * Describes the message myawesomelist.v1.GetProjectStatsRequest.
- * Use `create(GetProjectStatsRequestSchema)` to create a new message.
+ * Describes the enum myawesomelist.v1.TrendingWindow.
  */
-export const GetProjectStatsRequestSchema: GenMessage<GetProjectStatsRequest> =
+export const TrendingWindowSchema: GenEnum<TrendingWindow> =
   /*@__PURE__*/
-  messageDesc(file_myawesomelist_v1_myawesomelist, 15);
+  enumDesc(file_myawesomelist_v1_myawesomelist, 2);
 
 /**
- * @generated from message myawesomelist.v1.GetProjectStatsResponse
+ * CollectionEventType is the kind of change recorded for a collection entry
+ *
+ * @generated from enum myawesomelist.v1.CollectionEventType
  */
-export type GetProjectStatsResponse =
-  Message<"myawesomelist.v1.GetProjectStatsResponse"> & {
-    /**
-     * @generated from field: myawesomelist.v1.ProjectStats stats = 1;
-     */
-    stats?: ProjectStats;
-  };
+export enum CollectionEventType {
+  /**
+   * @generated from enum value: COLLECTION_EVENT_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: COLLECTION_EVENT_TYPE_PROJECT_ADDED = 1;
+   */
+  PROJECT_ADDED = 1,
+
+  /**
+   * @generated from enum value: COLLECTION_EVENT_TYPE_PROJECT_REMOVED = 2;
+   */
+  PROJECT_REMOVED = 2,
+
+  /**
+   * @generated from enum value: COLLECTION_EVENT_TYPE_PROJECT_MOVED = 3;
+   */
+  PROJECT_MOVED = 3,
+
+  /**
+   * @generated from enum value: COLLECTION_EVENT_TYPE_PROJECT_DESCRIPTION_EDITED = 4;
+   */
+  PROJECT_DESCRIPTION_EDITED = 4,
+}
 
 /**
- * Describes the message myawesomelist.v1.GetProjectStatsResponse.
- * Use `create(GetProjectStatsResponseSchema)` to create a new message.
+ * Describes the enum myawesomelist.v1.CollectionEventType.
  */
-export const GetProjectStatsResponseSchema: GenMessage<GetProjectStatsResponse> =
+export const CollectionEventTypeSchema: GenEnum<CollectionEventType> =
   /*@__PURE__*/
-  messageDesc(file_myawesomelist_v1_myawesomelist, 16);
+  enumDesc(file_myawesomelist_v1_myawesomelist, 3);
+
+/**
+ * ProjectOrderBy selects how listed projects are sorted
+ *
+ * @generated from enum myawesomelist.v1.ProjectOrderBy
+ */
+export enum ProjectOrderBy {
+  /**
+   * Keep the order of the awesome list
+   *
+   * @generated from enum value: PROJECT_ORDER_BY_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: PROJECT_ORDER_BY_NAME = 1;
+   */
+  NAME = 1,
+
+  /**
+   * Healthiest first, unscored projects last
+   *
+   * @generated from enum value: PROJECT_ORDER_BY_HEALTH_SCORE = 2;
+   */
+  HEALTH_SCORE = 2,
+
+  /**
+   * Most depended upon by other catalogued repositories first
+   *
+   * @generated from enum value: PROJECT_ORDER_BY_DEPENDENTS_COUNT = 3;
+   */
+  DEPENDENTS_COUNT = 3,
+
+  /**
+   * Most recently added to the awesome list first
+   *
+   * @generated from enum value: PROJECT_ORDER_BY_RECENTLY_ADDED = 4;
+   */
+  RECENTLY_ADDED = 4,
+}
+
+/**
+ * Describes the enum myawesomelist.v1.ProjectOrderBy.
+ */
+export const ProjectOrderBySchema: GenEnum<ProjectOrderBy> =
+  /*@__PURE__*/
+  enumDesc(file_myawesomelist_v1_myawesomelist, 4);
 
 /**
  * @generated from service myawesomelist.v1.AwesomeService
@@ -467,6 +1671,14 @@ export const AwesomeService: GenService<{
     input: typeof GetCollectionRequestSchema;
     output: typeof GetCollectionResponseSchema;
   };
+  /**
+   * @generated from rpc myawesomelist.v1.AwesomeService.ListCollectionChanges
+   */
+  listCollectionChanges: {
+    methodKind: "unary";
+    input: typeof ListCollectionChangesRequestSchema;
+    output: typeof ListCollectionChangesResponseSchema;
+  };
   /**
    * @generated from rpc myawesomelist.v1.AwesomeService.ListCategories
    */
@@ -491,6 +1703,14 @@ export const AwesomeService: GenService<{
     input: typeof SearchProjectsRequestSchema;
     output: typeof SearchProjectsResponseSchema;
   };
+  /**
+   * @generated from rpc myawesomelist.v1.AwesomeService.FindSimilarProjects
+   */
+  findSimilarProjects: {
+    methodKind: "unary";
+    input: typeof FindSimilarProjectsRequestSchema;
+    output: typeof FindSimilarProjectsResponseSchema;
+  };
   /**
    * @generated from rpc myawesomelist.v1.AwesomeService.GetProjectStats
    */
@@ -499,4 +1719,36 @@ export const AwesomeService: GenService<{
     input: typeof GetProjectStatsRequestSchema;
     output: typeof GetProjectStatsResponseSchema;
   };
+  /**
+   * @generated from rpc myawesomelist.v1.AwesomeService.GetProjectStatsHistory
+   */
+  getProjectStatsHistory: {
+    methodKind: "unary";
+    input: typeof GetProjectStatsHistoryRequestSchema;
+    output: typeof GetProjectStatsHistoryResponseSchema;
+  };
+  /**
+   * @generated from rpc myawesomelist.v1.AwesomeService.ListTrendingProjects
+   */
+  listTrendingProjects: {
+    methodKind: "unary";
+    input: typeof ListTrendingProjectsRequestSchema;
+    output: typeof ListTrendingProjectsResponseSchema;
+  };
+  /**
+   * @generated from rpc myawesomelist.v1.AwesomeService.ListDependencies
+   */
+  listDependencies: {
+    methodKind: "unary";
+    input: typeof ListDependenciesRequestSchema;
+    output: typeof ListDependenciesResponseSchema;
+  };
+  /**
+   * @generated from rpc myawesomelist.v1.AwesomeService.ListDependents
+   */
+  listDependents: {
+    methodKind: "unary";
+    input: typeof ListDependentsRequestSchema;
+    output: typeof ListDependentsResponseSchema;
+  };
 }> = /*@__PURE__*/ serviceDesc(file_myawesomelist_v1_myawesomelist, 0);